@@ -0,0 +1,146 @@
+package naturaldate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Unit is a unit of time used to build relative expressions.
+type Unit int
+
+// Units available.
+const (
+	Minutes Unit = iota + 1
+	Hours
+	Days
+	BusinessDays
+	Weeks
+	Months
+	Years
+)
+
+// units are the names of units in expressions.
+var units = map[Unit]string{
+	Minutes:      "minute",
+	Hours:        "hour",
+	Days:         "day",
+	BusinessDays: "business day",
+	Weeks:        "week",
+	Months:       "month",
+	Years:        "year",
+}
+
+// Now returns an expression for the reference time.
+func Now() Expr {
+	return MustCompile("now")
+}
+
+// Today returns an expression for the start of the reference day.
+func Today() Expr {
+	return MustCompile("today")
+}
+
+// Tomorrow returns an expression for the start of the day after the
+// reference day.
+func Tomorrow() Expr {
+	return MustCompile("tomorrow")
+}
+
+// Yesterday returns an expression for the start of the day before the
+// reference day.
+func Yesterday() Expr {
+	return MustCompile("yesterday")
+}
+
+// Next returns an expression for the following weekday, such as
+// "next friday".
+func Next(d time.Weekday) Expr {
+	if d < time.Sunday || d > time.Saturday {
+		return Expr{}.fail(fmt.Errorf("invalid weekday %d", d))
+	}
+	return MustCompile("next " + strings.ToLower(d.String()))
+}
+
+// Last returns an expression for the previous weekday, such as
+// "last friday".
+func Last(d time.Weekday) Expr {
+	if d < time.Sunday || d > time.Saturday {
+		return Expr{}.fail(fmt.Errorf("invalid weekday %d", d))
+	}
+	return MustCompile("last " + strings.ToLower(d.String()))
+}
+
+// Ago returns an expression for n units before the reference time, such as
+// "3 days ago".
+func Ago(n int, u Unit) Expr {
+	s, err := quantity(n, u)
+	if err != nil {
+		return Expr{}.fail(err)
+	}
+	return MustCompile(s + " ago")
+}
+
+// In returns an expression for n units after the reference time, such as
+// "3 days from now".
+func In(n int, u Unit) Expr {
+	s, err := quantity(n, u)
+	if err != nil {
+		return Expr{}.fail(err)
+	}
+	return MustCompile(s + " from now")
+}
+
+// At returns the expression at the given time of day, such as
+// "next friday at 5pm".
+func (e Expr) At(hour, min int) Expr {
+	switch {
+	case hour < 0 || hour > 23:
+		return e.fail(fmt.Errorf("invalid hour %d", hour))
+	case min < 0 || min > 59:
+		return e.fail(fmt.Errorf("invalid minute %d", min))
+	}
+	return e.then("at " + clock12(hour, min, 0))
+}
+
+// With returns the expression evaluated with the given options in addition
+// to its own.
+func (e Expr) With(options ...Option) Expr {
+	v := e
+	v.options = append(append([]Option(nil), e.options...), options...)
+	return v
+}
+
+// then returns the expression followed by s.
+func (e Expr) then(s string) Expr {
+	if e.err != nil {
+		return e
+	}
+	return MustCompile(strings.TrimSpace(e.source+" "+s), e.options...)
+}
+
+// fail returns the expression with error err, unless it already has one.
+func (e Expr) fail(err error) Expr {
+	if e.err == nil {
+		e.err = err
+	}
+	return e
+}
+
+// quantity returns n units in expressions, such as "1 day" or "3 days".
+func quantity(n int, u Unit) (string, error) {
+	name, ok := units[u]
+	if !ok {
+		return "", fmt.Errorf("invalid unit %d", u)
+	}
+
+	if n < 0 {
+		return "", fmt.Errorf("invalid quantity %d", n)
+	}
+
+	if n != 1 {
+		name += "s"
+	}
+
+	return fmt.Sprintf("%d %s", n, name), nil
+}
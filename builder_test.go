@@ -0,0 +1,81 @@
+package naturaldate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tj/assert"
+)
+
+// builderCases are test cases for built expressions.
+var builderCases = []struct {
+	Expr   Expr
+	String string
+	Output string
+}{
+	{Now(), `now`, `2019-11-25 13:07:18 +0000 UTC`},
+	{Today().At(9, 0), `today at 9am`, `2019-11-25 09:00:00 +0000 UTC`},
+	{Tomorrow().At(0, 0), `tomorrow at midnight`, `2019-11-26 00:00:00 +0000 UTC`},
+	{Yesterday().At(12, 0), `yesterday at noon`, `2019-11-24 12:00:00 +0000 UTC`},
+	{Next(time.Friday).At(17, 0), `next friday at 5pm`, `2019-11-29 17:00:00 +0000 UTC`},
+	{Last(time.Monday).At(8, 30), `last monday at 8:30am`, `2019-11-18 08:30:00 +0000 UTC`},
+	{Ago(3, Days), `3 days ago`, `2019-11-22 00:00:00 +0000 UTC`},
+	{Ago(1, Hours), `1 hour ago`, `2019-11-25 12:07:18 +0000 UTC`},
+	{In(2, Weeks), `2 weeks from now`, `2019-12-09 13:07:18 +0000 UTC`},
+	{In(1, Months).At(7, 0), `1 month from now at 7am`, `2019-12-25 07:00:00 +0000 UTC`},
+	{In(5, BusinessDays), `5 business days from now`, `2019-12-02 13:07:18 +0000 UTC`},
+	{Ago(10, Minutes), `10 minutes ago`, `2019-11-25 12:57:18 +0000 UTC`},
+	{In(1, Years), `1 year from now`, `2020-11-25 13:07:18 +0000 UTC`},
+}
+
+// Test building expressions.
+func TestBuilder(t *testing.T) {
+	for _, c := range builderCases {
+		t.Run(c.String, func(t *testing.T) {
+			assert.Equal(t, c.String, c.Expr.String())
+			assert.Equal(t, c.Output, c.Expr.Eval(base).String())
+
+			e, err := Compile(c.String)
+			assert.NoError(t, err, "compiling")
+			assert.Equal(t, c.Expr.Eval(base), e.Eval(base))
+		})
+	}
+}
+
+// Test building expressions with options.
+func TestBuilder_With(t *testing.T) {
+	e := In(1, BusinessDays).With(WithHolidays(USHolidays))
+	assert.Equal(t, `2019-11-29 13:07:18 +0000 UTC`, e.Eval(time.Date(2019, 11, 27, 13, 7, 18, 0, time.UTC)).String())
+	assert.Equal(t, `2019-11-28 13:07:18 +0000 UTC`, In(1, BusinessDays).Eval(time.Date(2019, 11, 27, 13, 7, 18, 0, time.UTC)).String())
+}
+
+// Test building invalid expressions.
+func TestBuilder_invalid(t *testing.T) {
+	cases := []struct {
+		Expr  Expr
+		Error string
+	}{
+		{Today().At(24, 0), `invalid hour 24`},
+		{Today().At(9, 60), `invalid minute 60`},
+		{In(-1, Days), `invalid quantity -1`},
+		{Ago(-3, Hours).At(9, 0), `invalid quantity -3`},
+		{Next(time.Weekday(7)).At(25, 0), `invalid weekday 7`},
+		{Last(time.Weekday(-1)), `invalid weekday -1`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Error, func(t *testing.T) {
+			assert.EqualError(t, c.Expr.Err(), c.Error)
+			assert.True(t, c.Expr.Eval(base).IsZero())
+
+			_, err := c.Expr.EvalError(base)
+			assert.EqualError(t, err, c.Error)
+
+			_, err = c.Expr.MarshalText()
+			assert.EqualError(t, err, c.Error)
+		})
+	}
+
+	assert.NoError(t, Today().At(9, 0).Err())
+	assert.EqualError(t, Ago(1, Unit(0)).Err(), `invalid unit 0`)
+}
@@ -0,0 +1,631 @@
+package naturaldate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// field is a field of a calendar specification.
+type field struct {
+	// values matched, or every value when nil.
+	values []int
+
+	// step repeats the first value every step when positive.
+	step int
+}
+
+// spec is a recurrence as the fields of a calendar specification.
+type spec struct {
+	second  int
+	minute  field
+	hour    field
+	day     field
+	month   field
+	weekday field
+}
+
+// Cron returns the recurrence as a cron expression, such as "30 2 * * 1-5"
+// for "every weekday at 2:30am". An error is returned when the recurrence
+// is not representable.
+func (r Recurrence) Cron() (string, error) {
+	s, err := r.spec()
+	if err != nil {
+		return "", err
+	}
+
+	if s.second != 0 {
+		return "", errors.New("seconds are not representable in cron")
+	}
+
+	if s.day.values != nil && s.weekday.values != nil {
+		return "", errors.New("days of the month and week together are not representable in cron")
+	}
+
+	return strings.Join([]string{
+		cronField(s.minute, 0, 59),
+		cronField(s.hour, 0, 23),
+		cronField(s.day, 1, 31),
+		cronField(s.month, 1, 12),
+		cronField(s.weekday, 0, 6),
+	}, " "), nil
+}
+
+// OnCalendar returns the recurrence as a systemd calendar event, such as
+// "Mon..Fri *-*-* 02:30:00" for "every weekday at 2:30am". An error is
+// returned when the recurrence is not representable.
+func (r Recurrence) OnCalendar() (string, error) {
+	s, err := r.spec()
+	if err != nil {
+		return "", err
+	}
+
+	v := fmt.Sprintf("*-%s-%s %s:%s:%02d",
+		calendarField(s.month),
+		calendarField(s.day),
+		calendarField(s.hour),
+		calendarField(s.minute),
+		s.second)
+
+	if s.weekday.values != nil {
+		v = weekdayList(s.weekday.values) + " " + v
+	}
+
+	return v, nil
+}
+
+// spec returns the calendar specification of the recurrence.
+func (r Recurrence) spec() (spec, error) {
+	if r.Count > 0 || !r.Until.IsZero() {
+		return spec{}, errors.New("recurrence end is not representable")
+	}
+
+	if r.DayEnd != 0 {
+		return spec{}, errors.New("end of day within an hour is not representable")
+	}
+
+	start := r.Start
+	n := r.interval()
+	s := spec{
+		second: start.Second(),
+		minute: field{values: r.minutes()},
+		hour:   field{values: r.Hours},
+	}
+
+	if len(r.Hours) == 0 {
+		s.hour.values = []int{start.Hour()}
+	}
+
+	switch r.Frequency {
+	case Minutely:
+		if 60%n != 0 {
+			return spec{}, fmt.Errorf("interval of %d minutes is not representable", n)
+		}
+		if len(r.Minutes) > 0 {
+			return spec{}, errors.New("minutes with an interval are not representable")
+		}
+		s.minute = field{}
+		if n > 1 {
+			s.minute = field{values: []int{start.Minute() % n}, step: n}
+		}
+		s.hour = field{values: r.Hours}
+	case Hourly:
+		if 24%n != 0 {
+			return spec{}, fmt.Errorf("interval of %d hours is not representable", n)
+		}
+		if len(r.Hours) > 0 && n > 1 {
+			return spec{}, errors.New("hours with an interval are not representable")
+		}
+		s.hour = field{values: r.Hours}
+		if n > 1 {
+			s.hour = field{values: []int{start.Hour() % n}, step: n}
+		}
+	case Daily:
+		if n > 1 {
+			return spec{}, fmt.Errorf("interval of %d days is not representable", n)
+		}
+	case Weekly:
+		if n > 1 {
+			return spec{}, fmt.Errorf("interval of %d weeks is not representable", n)
+		}
+	case Monthly:
+		if 12%n != 0 {
+			return spec{}, fmt.Errorf("interval of %d months is not representable", n)
+		}
+		if n > 1 {
+			s.month = field{values: []int{(int(start.Month())-1)%n + 1}, step: n}
+		}
+	case Yearly:
+		if n > 1 {
+			return spec{}, fmt.Errorf("interval of %d years is not representable", n)
+		}
+	default:
+		return spec{}, fmt.Errorf("unhandled frequency %d", r.Frequency)
+	}
+
+	if len(r.NthWeekdays) > 0 {
+		return spec{}, errors.New("numbered days of the week are not representable")
+	}
+
+	weekdays := r.Weekdays
+	monthDays := r.MonthDays
+	months := r.Months
+
+	switch r.Frequency {
+	case Weekly:
+		if len(weekdays) == 0 {
+			weekdays = []time.Weekday{start.Weekday()}
+		}
+	case Monthly:
+		if len(weekdays) == 0 && len(monthDays) == 0 {
+			monthDays = []int{start.Day()}
+		}
+	case Yearly:
+		if len(weekdays) == 0 && len(months) == 0 {
+			months = []time.Month{start.Month()}
+		}
+		if len(weekdays) == 0 && len(monthDays) == 0 {
+			monthDays = []int{start.Day()}
+		}
+	}
+
+	for _, d := range weekdays {
+		s.weekday.values = append(s.weekday.values, int(d))
+	}
+
+	for _, d := range monthDays {
+		if d < 0 {
+			return spec{}, errors.New("days counted from the end of the month are not representable")
+		}
+		s.day.values = append(s.day.values, d)
+	}
+
+	if len(months) > 0 {
+		if s.month.step > 0 {
+			return spec{}, errors.New("months with an interval are not representable")
+		}
+		for _, m := range months {
+			s.month.values = append(s.month.values, int(m))
+		}
+	}
+
+	if !s.occurs() {
+		return spec{}, errors.New("recurrence which never occurs is not representable")
+	}
+
+	return s, nil
+}
+
+// occurs returns true if a day of the month of the specification exists in
+// one of its months, in leap years for February 29th.
+func (s spec) occurs() bool {
+	if s.day.values == nil {
+		return true
+	}
+
+	months := s.month.values
+	switch {
+	case s.month.step > 0:
+		months = nil
+		for m := s.month.values[0]; m <= 12; m += s.month.step {
+			months = append(months, m)
+		}
+	case months == nil:
+		months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	}
+
+	for _, m := range months {
+		for _, d := range s.day.values {
+			if d <= daysIn(2020, time.Month(m)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// cronField returns a cron field, where min and max are the range of its
+// values.
+func cronField(f field, min, max int) string {
+	switch {
+	case len(f.values) == 0:
+		return "*"
+	case f.step > 0 && f.values[0] == min:
+		return "*/" + strconv.Itoa(f.step)
+	case f.step > 0:
+		return fmt.Sprintf("%d-%d/%d", f.values[0], max, f.step)
+	default:
+		return list(f.values, "-", strconv.Itoa)
+	}
+}
+
+// calendarField returns a systemd calendar event field.
+func calendarField(f field) string {
+	switch {
+	case len(f.values) == 0:
+		return "*"
+	case f.step > 0:
+		return fmt.Sprintf("%02d/%d", f.values[0], f.step)
+	default:
+		return list(f.values, "..", func(n int) string {
+			return fmt.Sprintf("%02d", n)
+		})
+	}
+}
+
+// weekdayList returns days of the week as a systemd list, such as
+// "Mon..Fri", with weeks starting on Monday.
+func weekdayList(days []int) string {
+	monday := make([]int, len(days))
+	for i, d := range days {
+		monday[i] = (d+6)%7 + 1
+	}
+	return list(monday, "..", func(n int) string {
+		return time.Weekday(n % 7).String()[:3]
+	})
+}
+
+// list returns the values sorted and formatted as a comma separated list,
+// where runs of three or more values are joined as ranges with sep.
+func list(values []int, sep string, format func(int) string) string {
+	v := append([]int(nil), values...)
+	sort.Ints(v)
+
+	var parts []string
+	for i := 0; i < len(v); {
+		j := i
+		for j+1 < len(v) && v[j+1] <= v[j]+1 {
+			j++
+		}
+
+		switch {
+		case v[j]-v[i] >= 2:
+			parts = append(parts, format(v[i])+sep+format(v[j]))
+		case v[j] != v[i]:
+			parts = append(parts, format(v[i]), format(v[j]))
+		default:
+			parts = append(parts, format(v[i]))
+		}
+
+		i = j + 1
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// cronMonths are the names of months in cron expressions.
+var cronMonths = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+// cronWeekdays are the names of days of the week in cron expressions.
+var cronWeekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// DescribeCron returns a 5 field cron expression, or 6 fields with seconds
+// first, as a recurrence such as "every 15 minutes on weekdays from 9am to
+// 5pm" for "*/15 9-17 * * 1-5". The description is parsed by
+// ParseRecurrence as the same schedule, using the default weekend.
+func DescribeCron(s string) (string, error) {
+	fields := strings.Fields(s)
+	seconds := []int{0}
+
+	switch len(fields) {
+	case 5:
+	case 6:
+		v, err := parseCronField(fields[0], 0, 59, nil)
+		if err != nil {
+			return "", fmt.Errorf("parsing seconds: %w", err)
+		}
+		seconds, fields = v, fields[1:]
+	default:
+		return "", fmt.Errorf("expected 5 or 6 fields in %q", s)
+	}
+
+	minutes, err := parseCronField(fields[0], 0, 59, nil)
+	if err != nil {
+		return "", fmt.Errorf("parsing minutes: %w", err)
+	}
+
+	hours, err := parseCronField(fields[1], 0, 23, nil)
+	if err != nil {
+		return "", fmt.Errorf("parsing hours: %w", err)
+	}
+
+	days, err := parseCronField(fields[2], 1, 31, nil)
+	if err != nil {
+		return "", fmt.Errorf("parsing days: %w", err)
+	}
+
+	months, err := parseCronField(fields[3], 1, 12, cronMonths)
+	if err != nil {
+		return "", fmt.Errorf("parsing months: %w", err)
+	}
+
+	weekdays, err := parseCronField(fields[4], 0, 7, cronWeekdays)
+	if err != nil {
+		return "", fmt.Errorf("parsing weekdays: %w", err)
+	}
+
+	if hasInt(weekdays, 7) {
+		weekdays = addInt(weekdays[:len(weekdays)-1], 0)
+	}
+
+	if len(seconds) > 1 {
+		return "", fmt.Errorf("cannot describe seconds %q", fields[0])
+	}
+
+	everyDay := len(days) == 31
+	everyWeekday := len(weekdays) == 7
+	if !everyDay && !everyWeekday {
+		return "", errors.New("cannot describe days of the month and week together")
+	}
+
+	var parts, clock []string
+	daily := false
+	second := seconds[0]
+
+	everyHour := len(hours) == 24
+	hourRange := !everyHour && hours[len(hours)-1]-hours[0] == len(hours)-1 && len(hours) > 1
+	if hourRange {
+		clock = append(clock, "from "+clock12(hours[0], 0, 0)+" to "+clock12(hours[len(hours)-1], minutes[len(minutes)-1], 0))
+	}
+
+	minuteStep := cronStep(minutes, 60)
+	hourStep := cronStep(hours, 24)
+
+	switch {
+	case minuteStep > 0 && (everyHour || hourRange):
+		parts = append(parts, every(minuteStep, "minute"))
+	case len(minutes) == 1 && hourStep > 1:
+		parts = append(parts, every(hourStep, "hour"))
+		clock = append(clock, minutesPast(minutes))
+	case everyHour || hourRange:
+		parts = append(parts, "every hour")
+		clock = append(clock, minutesPast(minutes))
+	case len(hours)*len(minutes) <= 24:
+		var times []string
+		for _, h := range hours {
+			for _, m := range minutes {
+				times = append(times, clock12(h, m, second))
+			}
+		}
+		clock = []string{"at " + and(times)}
+		daily = true
+	default:
+		return "", fmt.Errorf("cannot describe %q", s)
+	}
+
+	if !daily && second != 0 {
+		return "", fmt.Errorf("cannot describe seconds %q", fields[0])
+	}
+
+	switch {
+	case daily && !everyWeekday:
+		parts = append(parts, "every "+weekdayNames(weekdays, false))
+	case daily && !everyDay:
+		parts = append(parts, "every month on the "+ordinalList(days))
+	case daily:
+		parts = append(parts, "every day")
+	case !everyWeekday:
+		parts = append(parts, "on "+weekdayNames(weekdays, true))
+	case !everyDay:
+		parts = append(parts, "on the "+ordinalList(days))
+	}
+
+	if len(months) < 12 {
+		var names []string
+		for _, m := range months {
+			names = append(names, strings.ToLower(time.Month(m).String()))
+		}
+		parts = append(parts, "in "+and(names))
+	}
+
+	for _, c := range clock {
+		if c != "" {
+			parts = append(parts, c)
+		}
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+// parseCronField parses a cron field of values from min to max, returning
+// them sorted. Values may be given by names, starting from min.
+func parseCronField(s string, min, max int, names []string) ([]int, error) {
+	var values []int
+
+	for _, part := range strings.Split(strings.ToLower(s), ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i != -1 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid step %q", part)
+			}
+			step, part = n, part[:i]
+		}
+
+		lo, hi := min, max
+		switch {
+		case part == "*" || part == "?":
+		case strings.Contains(part, "-"):
+			i := strings.Index(part, "-")
+			a, err := parseCronValue(part[:i], min, max, names)
+			if err != nil {
+				return nil, err
+			}
+			b, err := parseCronValue(part[i+1:], min, max, names)
+			if err != nil {
+				return nil, err
+			}
+			if b < a {
+				return nil, fmt.Errorf("invalid range %q", part)
+			}
+			lo, hi = a, b
+		default:
+			n, err := parseCronValue(part, min, max, names)
+			if err != nil {
+				return nil, err
+			}
+			lo = n
+			if step == 1 {
+				hi = n
+			}
+		}
+
+		for n := lo; n <= hi; n += step {
+			values = addInt(values, n)
+		}
+	}
+
+	return values, nil
+}
+
+// parseCronValue parses a cron value from min to max, or its name.
+func parseCronValue(s string, min, max int, names []string) (int, error) {
+	for i, name := range names {
+		if s == name {
+			return min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	return n, nil
+}
+
+// cronStep returns the step of values repeating from zero until n, which
+// is one for every value, or zero when the values do not repeat.
+func cronStep(values []int, n int) int {
+	if len(values) < 2 || values[0] != 0 {
+		return 0
+	}
+
+	step := values[1]
+	if n%step != 0 || len(values) != n/step {
+		return 0
+	}
+
+	for i, v := range values {
+		if v != i*step {
+			return 0
+		}
+	}
+
+	return step
+}
+
+// every returns "every" n units.
+func every(n int, unit string) string {
+	if n == 1 {
+		return "every " + unit
+	}
+	return fmt.Sprintf("every %d %ss", n, unit)
+}
+
+// minutesPast returns the minutes past the hour, or nothing on the hour.
+func minutesPast(minutes []int) string {
+	if len(minutes) == 1 && minutes[0] == 0 {
+		return ""
+	}
+
+	var v []string
+	for _, m := range minutes {
+		v = append(v, strconv.Itoa(m))
+	}
+
+	return "at " + and(v) + " minutes past the hour"
+}
+
+// clock12 returns a time of day on the 12-hour clock, such as "9:30am".
+func clock12(hour, min, sec int) string {
+	switch {
+	case hour == 0 && min == 0 && sec == 0:
+		return "midnight"
+	case hour == 12 && min == 0 && sec == 0:
+		return "noon"
+	}
+
+	suffix := "am"
+	if hour >= 12 {
+		suffix = "pm"
+	}
+
+	h := hour % 12
+	if h == 0 {
+		h = 12
+	}
+
+	switch {
+	case sec != 0:
+		return fmt.Sprintf("%d:%02d:%02d%s", h, min, sec, suffix)
+	case min != 0:
+		return fmt.Sprintf("%d:%02d%s", h, min, suffix)
+	default:
+		return fmt.Sprintf("%d%s", h, suffix)
+	}
+}
+
+// weekdayNames returns days of the week such as "monday and friday", in
+// plural when given, with weeks starting on Monday.
+func weekdayNames(days []int, plural bool) string {
+	if len(days) == 5 && days[0] == 1 && days[4] == 5 {
+		if plural {
+			return "weekdays"
+		}
+		return "weekday"
+	}
+
+	order := days
+	if days[0] == 0 {
+		order = append(append([]int(nil), days[1:]...), 0)
+	}
+
+	var names []string
+	for _, d := range order {
+		name := strings.ToLower(time.Weekday(d).String())
+		if plural {
+			name += "s"
+		}
+		names = append(names, name)
+	}
+
+	return and(names)
+}
+
+// ordinalList returns days of the month such as "1st and 15th".
+func ordinalList(days []int) string {
+	var v []string
+	for _, d := range days {
+		v = append(v, ordinal(d))
+	}
+	return and(v)
+}
+
+// ordinal returns n with its ordinal suffix, such as "21st".
+func ordinal(n int) string {
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+		return fmt.Sprintf("%dth", n)
+	case n%10 == 1:
+		return fmt.Sprintf("%dst", n)
+	case n%10 == 2:
+		return fmt.Sprintf("%dnd", n)
+	case n%10 == 3:
+		return fmt.Sprintf("%drd", n)
+	default:
+		return fmt.Sprintf("%dth", n)
+	}
+}
+
+// and returns values as a list such as "a, b and c".
+func and(values []string) string {
+	if len(values) < 2 {
+		return strings.Join(values, "")
+	}
+	return strings.Join(values[:len(values)-1], ", ") + " and " + values[len(values)-1]
+}
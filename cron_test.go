@@ -0,0 +1,127 @@
+package naturaldate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tj/assert"
+)
+
+var cronCases = []struct {
+	Input      string
+	Cron       string
+	OnCalendar string
+}{
+	{`every weekday at 2:30am`, `30 2 * * 1-5`, `Mon..Fri *-*-* 02:30:00`},
+	{`every 15 minutes`, `*/15 * * * *`, `*-*-* *:00/15:00`},
+	{`every 2 hours`, `0 */2 * * *`, `*-*-* 00/2:00:00`},
+	{`daily at noon`, `0 12 * * *`, `*-*-* 12:00:00`},
+	{`every monday and wednesday at 9am`, `0 9 * * 1,3`, `Mon,Wed *-*-* 09:00:00`},
+	{`every weekend at 10am`, `0 10 * * 0,6`, `Sat,Sun *-*-* 10:00:00`},
+	{`every sunday`, `0 0 * * 0`, `Sun *-*-* 00:00:00`},
+	{`every month on the 1st and 15th at 6pm`, `0 18 1,15 * *`, `*-*-01,15 18:00:00`},
+	{`every 3 months on the 1st`, `0 0 1 2-12/3 *`, `*-02/3-01 00:00:00`},
+	{`every month except december`, `0 0 25 1-11 *`, `*-01..11-25 00:00:00`},
+	{`yearly`, `0 0 25 11 *`, `*-11-25 00:00:00`},
+	{`every monday at 9:30:15`, `seconds are not representable in cron`, `Mon *-*-* 09:30:15`},
+	{`every other day`, `interval of 2 days is not representable`, `interval of 2 days is not representable`},
+	{`every 7 minutes`, `interval of 7 minutes is not representable`, `interval of 7 minutes is not representable`},
+	{`every day for 10 times`, `recurrence end is not representable`, `recurrence end is not representable`},
+	{`every year on the 30th in february`, `recurrence which never occurs is not representable`, `recurrence which never occurs is not representable`},
+	{`every year on the 29th in february`, `0 0 29 2 *`, `*-02-29 00:00:00`},
+	{`every month on the 31st except january, march, may, july, august, october and december`, `recurrence which never occurs is not representable`, `recurrence which never occurs is not representable`},
+}
+
+// Test converting recurrences to cron expressions and calendar events.
+func TestRecurrence_Cron(t *testing.T) {
+	for _, c := range cronCases {
+		t.Run(c.Input, func(t *testing.T) {
+			r, err := ParseRecurrence(c.Input, base)
+			assert.NoError(t, err, "parsing")
+
+			v, err := r.Cron()
+			if err != nil {
+				v = err.Error()
+			}
+			assert.Equal(t, c.Cron, v)
+
+			v, err = r.OnCalendar()
+			if err != nil {
+				v = err.Error()
+			}
+			assert.Equal(t, c.OnCalendar, v)
+		})
+	}
+}
+
+// Test converting recurrences on days of the month and week to cron.
+func TestRecurrence_Cron_days(t *testing.T) {
+	r, err := ParseRRule(`FREQ=MONTHLY;BYMONTHDAY=13;BYDAY=FR`, truncateDay(base))
+	assert.NoError(t, err, "parsing")
+
+	_, err = r.Cron()
+	assert.EqualError(t, err, `days of the month and week together are not representable in cron`)
+
+	v, err := r.OnCalendar()
+	assert.NoError(t, err, "converting")
+	assert.Equal(t, `Fri *-*-13 00:00:00`, v)
+}
+
+var describeCases = []struct {
+	Input  string
+	Output string
+}{
+	{`*/15 9-17 * * 1-5`, `every 15 minutes on weekdays from 9am to 5:45pm`},
+	{`30 2 * * 1-5`, `every weekday at 2:30am`},
+	{`0 9 * * *`, `every day at 9am`},
+	{`0 0 * * *`, `every day at midnight`},
+	{`0 12 * * sun`, `every sunday at noon`},
+	{`0 9,17 * * *`, `every day at 9am and 5pm`},
+	{`* * * * *`, `every minute`},
+	{`*/5 * * * *`, `every 5 minutes`},
+	{`0 * * * *`, `every hour`},
+	{`15,45 * * * *`, `every hour at 15 and 45 minutes past the hour`},
+	{`0 */2 * * *`, `every 2 hours`},
+	{`30 */6 * * *`, `every 6 hours at 30 minutes past the hour`},
+	{`0 9-17 * * mon-fri`, `every hour on weekdays from 9am to 5pm`},
+	{`30 9-17 * * *`, `every hour from 9am to 5:30pm at 30 minutes past the hour`},
+	{`0 10 * * 6,0`, `every saturday and sunday at 10am`},
+	{`0 10 * * 1,3,5`, `every monday, wednesday and friday at 10am`},
+	{`0 18 1,15 * *`, `every month on the 1st and 15th at 6pm`},
+	{`0 0 1 */3 *`, `every month on the 1st in january, april, july and october at midnight`},
+	{`0 8 * jan,jul *`, `every day in january and july at 8am`},
+	{`*/10 * 1 * *`, `every 10 minutes on the 1st`},
+	{`15 30 9 * * 7`, `every sunday at 9:30:15am`},
+	{`0 9 1 * 1`, `cannot describe days of the month and week together`},
+	{`*/20 9 * * *`, `every day at 9am, 9:20am and 9:40am`},
+	{`*/7 * * * *`, `every hour at 0, 7, 14, 21, 28, 35, 42, 49 and 56 minutes past the hour`},
+	{`*/15 */2 * * *`, `cannot describe "*/15 */2 * * *"`},
+	{`0 9 * *`, `expected 5 or 6 fields in "0 9 * *"`},
+	{`0 25 * * *`, `parsing hours: invalid value "25"`},
+	{`0 9 * * foo`, `parsing weekdays: invalid value "foo"`},
+}
+
+// Test describing cron expressions.
+func TestDescribeCron(t *testing.T) {
+	for _, c := range describeCases {
+		t.Run(c.Input, func(t *testing.T) {
+			v, err := DescribeCron(c.Input)
+			if err != nil {
+				assert.Equal(t, c.Output, err.Error())
+				return
+			}
+			assert.Equal(t, c.Output, v)
+
+			r, err := ParseRecurrence(v, base)
+			assert.NoError(t, err, "parsing description")
+
+			if fields := strings.Fields(c.Input); len(fields) == 5 {
+				expr, err := r.Cron()
+				assert.NoError(t, err, "converting")
+
+				expected, _ := DescribeCron(expr)
+				assert.Equal(t, v, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package naturaldate
+
+import (
+	"strings"
+	"time"
+)
+
+// Event is a calendar event parsed from text such as "lunch with bob
+// tomorrow at noon for an hour".
+type Event struct {
+	// Title is the text which is not part of the date, such as "lunch with bob".
+	Title string
+
+	// Start is the start of the event.
+	Start time.Time
+
+	// End is the exclusive end of the event. Events without a duration or
+	// time range end when they start, unless they refer to a whole period,
+	// such as "friday" or "next week".
+	End time.Time
+}
+
+// prepositions are dropped from titles when they introduce the date, such as
+// "at" in "lunch at noon".
+var prepositions = map[string]bool{
+	"at":    true,
+	"on":    true,
+	"from":  true,
+	"for":   true,
+	"in":    true,
+	"by":    true,
+	"to":    true,
+	"until": true,
+}
+
+// ParseEvent parses a calendar event such as "tomorrow at 3pm for 2 hours",
+// "standup monday 10-11am" or "dentist friday from 2pm to 4:30pm", returning
+// its start, end and title.
+func ParseEvent(s string, ref time.Time, options ...Option) (Event, error) {
+	p, err := parse(s, ref, append(options, func(p *parser) { p.event = true })...)
+	if err != nil {
+		return Event{}, err
+	}
+
+	e := Event{
+		Title: p.title(s),
+		Start: p.t,
+		End:   p.t.Add(p.duration),
+	}
+
+	if p.duration == 0 && !p.clock {
+		switch {
+		case p.period != periodNone:
+			e.Start = startOf(p.t, p.period, p.weekStart)
+			e.End = addPeriod(e.Start, p.period, 1)
+		case !p.t.Equal(ref):
+			e.Start = truncateDay(p.t)
+			e.End = e.Start.AddDate(0, 0, 1)
+		}
+	}
+
+	return e, nil
+}
+
+// VEvent returns the event as an iCalendar VEVENT component.
+func (e Event) VEvent() string {
+	if e.End.Equal(e.Start) {
+		return component("VEVENT", e.Title, e.Start)
+	}
+	return component("VEVENT", e.Title, e.Start, "DTEND"+dateTimeValue(e.End))
+}
+
+// VTodo returns the event as an iCalendar VTODO component, due at its end.
+func (e Event) VTodo() string {
+	if e.End.Equal(e.Start) {
+		return component("VTODO", e.Title, e.Start)
+	}
+	return component("VTODO", e.Title, e.Start, "DUE"+dateTimeValue(e.End))
+}
+
+// addWord records the position of a word which is not part of the date.
+func (p *parser) addWord(begin, end int) {
+	p.words = append(p.words, [2]int{begin, end})
+}
+
+// setEventStart records the start of a time range, where fixed is false for
+// hours which may adopt the meridiem of the end, such as "10" in "10-11pm".
+func (p *parser) setEventStart(fixed bool) {
+	p.from = p.t
+	p.meridiem = fixed
+}
+
+// setEventEnd sets the time and duration of a time range, ending on the
+// following day when the end is before the start.
+func (p *parser) setEventEnd() {
+	start, end := p.from, p.t
+	if !p.meridiem && start.Hour() < 12 && !start.Add(12*time.Hour).After(end) {
+		start = start.Add(12 * time.Hour)
+	}
+
+	d := end.Sub(start)
+	if d <= 0 {
+		d += day
+	}
+
+	p.t = start
+	p.duration = d
+}
+
+// title returns the words of s which are not part of the date, dropping
+// prepositions which introduce it.
+func (p *parser) title(s string) string {
+	text := []rune(s)
+	if len(text) != len(p.buffer)-1 {
+		text = p.buffer
+	}
+
+	var b strings.Builder
+	for i, w := range p.words {
+		next := len(p.buffer) - 1
+		if i+1 < len(p.words) {
+			next = p.words[i+1][0]
+		}
+
+		gap := strings.TrimSpace(string(p.buffer[w[1]:next]))
+		if gap != "" && prepositions[string(p.buffer[w[0]:w[1]])] {
+			continue
+		}
+
+		if b.Len() > 0 && (i == 0 || p.words[i-1][1] != w[0]) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(string(text[w[0]:w[1]]))
+	}
+
+	return strings.TrimRight(b.String(), ",;:-")
+}
@@ -0,0 +1,85 @@
+package naturaldate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tj/assert"
+)
+
+// eventCases are test cases for events.
+var eventCases = []struct {
+	Input string
+	Title string
+	Start string
+	End   string
+}{
+	{`tomorrow at 3pm for 2 hours`, ``, `2019-11-26 15:00:00 +0000 UTC`, `2019-11-26 17:00:00 +0000 UTC`},
+	{`Standup monday 10-11am`, `Standup`, `2019-12-02 10:00:00 +0000 UTC`, `2019-12-02 11:00:00 +0000 UTC`},
+	{`Dentist friday from 2pm to 4:30pm`, `Dentist`, `2019-11-29 14:00:00 +0000 UTC`, `2019-11-29 16:30:00 +0000 UTC`},
+	{`Lunch w/ Bob tomorrow at noon for an hour`, `Lunch w/ Bob`, `2019-11-26 12:00:00 +0000 UTC`, `2019-11-26 13:00:00 +0000 UTC`},
+	{`Sync between 14:00 and 15:30 today`, `Sync`, `2019-11-25 14:00:00 +0000 UTC`, `2019-11-25 15:30:00 +0000 UTC`},
+	{`flight for 1 hour 30 minutes tomorrow at 8am`, `flight`, `2019-11-26 08:00:00 +0000 UTC`, `2019-11-26 09:30:00 +0000 UTC`},
+	{`review in 2 hours for 30 minutes`, `review`, `2019-11-25 15:07:18 +0000 UTC`, `2019-11-25 15:37:18 +0000 UTC`},
+	{`party saturday 9pm-1am`, `party`, `2019-11-30 21:00:00 +0000 UTC`, `2019-12-01 01:00:00 +0000 UTC`},
+	{`gym tomorrow 6-7pm`, `gym`, `2019-11-26 18:00:00 +0000 UTC`, `2019-11-26 19:00:00 +0000 UTC`},
+	{`gym tomorrow 6-7`, `gym`, `2019-11-26 06:00:00 +0000 UTC`, `2019-11-26 07:00:00 +0000 UTC`},
+	{`dinner at 7pm`, `dinner`, `2019-11-25 19:00:00 +0000 UTC`, `2019-11-25 19:00:00 +0000 UTC`},
+	{`Meeting: review tomorrow`, `Meeting: review`, `2019-11-26 00:00:00 +0000 UTC`, `2019-11-27 00:00:00 +0000 UTC`},
+	{`Dentist, friday`, `Dentist`, `2019-11-29 00:00:00 +0000 UTC`, `2019-11-30 00:00:00 +0000 UTC`},
+	{`offsite next week`, `offsite`, `2019-12-02 00:00:00 +0000 UTC`, `2019-12-09 00:00:00 +0000 UTC`},
+	{`talk at the conference on december 5th`, `talk at the conference`, `2019-12-05 00:00:00 +0000 UTC`, `2019-12-06 00:00:00 +0000 UTC`},
+	{`Q4 planning tomorrow`, `Q4 planning`, `2019-11-26 00:00:00 +0000 UTC`, `2019-11-27 00:00:00 +0000 UTC`},
+	{`room 101 meeting at 3pm`, `room 101 meeting`, `2019-11-25 15:00:00 +0000 UTC`, `2019-11-25 15:00:00 +0000 UTC`},
+	{`interview with 3 candidates friday at 2pm`, `interview with 3 candidates`, `2019-11-29 14:00:00 +0000 UTC`, `2019-11-29 14:00:00 +0000 UTC`},
+	{`one on one with bob at 4`, `one on one with bob`, `2019-11-25 04:00:00 +0000 UTC`, `2019-11-25 04:00:00 +0000 UTC`},
+	{`B2B sync tomorrow 9:30`, `B2B sync`, `2019-11-26 09:30:00 +0000 UTC`, `2019-11-26 09:30:00 +0000 UTC`},
+	{`call at 5 a meeting`, `call a meeting`, `2019-11-25 05:00:00 +0000 UTC`, `2019-11-25 05:00:00 +0000 UTC`},
+	{`call mom`, `call mom`, `2019-11-25 13:07:18 +0000 UTC`, `2019-11-25 13:07:18 +0000 UTC`},
+}
+
+// Test parsing events.
+func TestParseEvent(t *testing.T) {
+	for _, c := range eventCases {
+		t.Run(c.Input, func(t *testing.T) {
+			e, err := ParseEvent(c.Input, base, WithDirection(Future))
+			assert.NoError(t, err, "parsing")
+			assert.Equal(t, c.Title, e.Title)
+			assert.Equal(t, c.Start, e.Start.UTC().String())
+			assert.Equal(t, c.End, e.End.UTC().String())
+		})
+	}
+}
+
+// Test parsing invalid events.
+func TestParseEvent_invalid(t *testing.T) {
+	_, err := ParseEvent(`lunch 13-14pm`, base)
+	assert.EqualError(t, err, `invalid hour 14`)
+}
+
+// Test formatting events as iCalendar components.
+func TestEvent_VEvent(t *testing.T) {
+	defer func(fn func() time.Time) { now = fn }(now)
+	now = func() time.Time { return base }
+
+	e, err := ParseEvent(`Dentist friday from 2pm to 4:30pm`, base, WithDirection(Future))
+	assert.NoError(t, err, "parsing")
+
+	assert.Equal(t, "BEGIN:VEVENT\r\n"+
+		"UID:ea976758bddc65c8@naturaldate\r\n"+
+		"DTSTAMP:20191125T130718Z\r\n"+
+		"DTSTART:20191129T140000Z\r\n"+
+		"DTEND:20191129T163000Z\r\n"+
+		"SUMMARY:Dentist\r\n"+
+		"END:VEVENT\r\n", e.VEvent())
+
+	e, err = ParseEvent(`pay rent tomorrow at noon`, base)
+	assert.NoError(t, err, "parsing")
+
+	assert.Equal(t, "BEGIN:VTODO\r\n"+
+		"UID:70ed80eab338ceb7@naturaldate\r\n"+
+		"DTSTAMP:20191125T130718Z\r\n"+
+		"DTSTART:20191126T120000Z\r\n"+
+		"SUMMARY:pay rent\r\n"+
+		"END:VTODO\r\n", e.VTodo())
+}
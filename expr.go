@@ -0,0 +1,112 @@
+package naturaldate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Expr is a compiled expression, which may be evaluated against any number of
+// reference times. Expressions are immutable and safe for concurrent use.
+type Expr struct {
+	source  string
+	tokens  []token32
+	options []Option
+	err     error
+}
+
+// Compile parses query string s once, returning an expression to evaluate
+// later with Eval. Options are applied on each evaluation.
+func Compile(s string, options ...Option) (Expr, error) {
+	s = strings.Join(strings.Fields(strings.ToLower(s)), " ")
+
+	p := newParser(s, time.Time{}, options)
+	p.Init()
+
+	if err := p.Parse(); err != nil {
+		return Expr{}, err
+	}
+
+	return Expr{
+		source:  s,
+		tokens:  append([]token32(nil), p.Tokens()...),
+		options: append([]Option(nil), options...),
+	}, nil
+}
+
+// MustCompile is like Compile but panics if the expression cannot be parsed.
+func MustCompile(s string, options ...Option) Expr {
+	e, err := Compile(s, options...)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Eval returns the time of the expression relative to ref. The zero time is
+// returned when the expression is invalid at ref, such as "february 30th",
+// see EvalError for the reason.
+func (e Expr) Eval(ref time.Time) time.Time {
+	t, _ := e.EvalError(ref)
+	return t
+}
+
+// EvalError is like Eval, returning an error when the expression is invalid
+// at ref.
+func (e Expr) EvalError(ref time.Time) (time.Time, error) {
+	if e.err != nil {
+		return time.Time{}, e.err
+	}
+
+	if e.tokens == nil {
+		return time.Time{}, fmt.Errorf("empty expression")
+	}
+
+	p := newParser(e.source, ref, e.options)
+	p.buffer = append([]rune(p.Buffer), endSymbol)
+	p.tokens32 = tokens32{tree: e.tokens}
+
+	if err := p.execute(); err != nil {
+		return time.Time{}, err
+	}
+
+	return p.t, nil
+}
+
+// Err returns the error of an expression built with invalid values, such as
+// an hour of 25, otherwise nil.
+func (e Expr) Err() error {
+	return e.err
+}
+
+// String returns the source of the expression in lowercase with single
+// spaces. It is not a canonical form, so equivalent expressions such as
+// "5pm" and "17:00" have different strings.
+func (e Expr) String() string {
+	return e.source
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (e Expr) MarshalText() ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return []byte(e.source), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Options are not part of
+// the text, so the expression is compiled without them. Empty text is the zero
+// expression, as marshalled by it.
+func (e *Expr) UnmarshalText(b []byte) error {
+	if strings.TrimSpace(string(b)) == "" {
+		*e = Expr{}
+		return nil
+	}
+
+	v, err := Compile(string(b))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
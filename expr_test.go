@@ -0,0 +1,89 @@
+package naturaldate
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tj/assert"
+)
+
+// Test evaluating compiled expressions.
+func TestCompile(t *testing.T) {
+	for _, c := range pastCases {
+		t.Run(c.Input, func(t *testing.T) {
+			e, err := Compile(c.Input)
+			if err != nil {
+				assert.Equal(t, c.Output, err.Error())
+				return
+			}
+			v, err := e.EvalError(base)
+			if err != nil {
+				assert.Equal(t, c.Output, err.Error())
+				return
+			}
+			assert.Equal(t, c.Output, v.UTC().String())
+		})
+	}
+}
+
+// Test evaluating compiled expressions against different reference times.
+func TestExpr_Eval(t *testing.T) {
+	e := MustCompile(`Tomorrow  at 9AM`, WithDirection(Future))
+	assert.Equal(t, `tomorrow at 9am`, e.String())
+	assert.Equal(t, `tomorrow at 09:00`, MustCompile(`tomorrow at 09:00`).String())
+	assert.Equal(t, `2019-11-26 09:00:00 +0000 UTC`, e.Eval(base).String())
+	assert.Equal(t, `2019-11-27 09:00:00 +0000 UTC`, e.Eval(base.AddDate(0, 0, 1)).String())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ref := base.AddDate(0, 0, i)
+			assert.Equal(t, ref.AddDate(0, 0, 1).Truncate(day).Add(9*time.Hour), e.Eval(ref))
+		}(i)
+	}
+	wg.Wait()
+}
+
+// Test compiling and evaluating invalid expressions.
+func TestExpr_invalid(t *testing.T) {
+	_, err := Compile(``)
+	assert.Error(t, err)
+
+	e := MustCompile(`february 30th`)
+	assert.True(t, e.Eval(base).IsZero())
+	_, err = e.EvalError(base)
+	assert.EqualError(t, err, `invalid day 30 for February 2019`)
+
+	_, err = Expr{}.EvalError(base)
+	assert.EqualError(t, err, `empty expression`)
+}
+
+// Test marshalling expressions as text.
+func TestExpr_MarshalText(t *testing.T) {
+	var v struct {
+		When Expr `json:"when"`
+	}
+
+	err := json.Unmarshal([]byte(`{ "when": "Next Friday at 5pm" }`), &v)
+	assert.NoError(t, err, "unmarshalling")
+	assert.Equal(t, `2019-11-29 17:00:00 +0000 UTC`, v.When.Eval(base).String())
+
+	b, err := json.Marshal(v)
+	assert.NoError(t, err, "marshalling")
+	assert.Equal(t, `{"when":"next friday at 5pm"}`, string(b))
+
+	err = json.Unmarshal([]byte(`{ "when": "" }`), &v)
+	assert.NoError(t, err, "unmarshalling")
+	assert.Equal(t, Expr{}, v.When)
+
+	b, err = json.Marshal(v)
+	assert.NoError(t, err, "marshalling")
+	assert.Equal(t, `{"when":""}`, string(b))
+
+	err = json.Unmarshal([]byte(`{ "when": "10:am" }`), &v)
+	assert.Error(t, err)
+}
@@ -1,6 +1,6 @@
 package naturaldate
 
-// Code generated by peg -inline -switch grammar.peg DO NOT EDIT
+// Code generated by peg -inline -switch grammar.peg DO NOT EDIT.
 
 import (
 	"fmt"
@@ -8,21 +8,58 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 )
 
 const endSymbol rune = 1114112
 
 /* The rule types inferred from the grammar are below. */
-type pegRule uint8
+type pegRule uint16
 
 const (
 	ruleUnknown pegRule = iota
 	ruleQuery
 	ruleExpr
+	ruleMoment
+	ruleEventDuration
+	ruleEventLength
+	ruleEventTimes
+	ruleEventStart
+	ruleEventEnd
+	ruleEventTime
+	ruleBareHour
+	ruleEventWord
+	ruleText
+	ruleRecurrence
+	ruleUntilMonth
+	ruleLimit
+	ruleMinutesPast
+	ruleMonths
+	ruleExclusion
+	ruleSchedule
+	ruleInterval
+	ruleRecurrenceUnit
+	ruleFrequency
+	ruleWeekdays
+	ruleMonthDays
+	ruleMonthDay
+	ruleAnd
+	ruleBoundary
+	rulePeriod
+	ruleUnit
+	ruleHoliday
+	ruleWeek
+	ruleNthWeekday
+	ruleNthDay
 	ruleRelativeMinutes
 	ruleRelativeHours
 	ruleRelativeDays
+	ruleOffset
+	ruleWeekdayInWeek
+	ruleRelativeWeek
+	ruleRelativeBusinessDays
+	ruleRelativeBusinessHours
 	ruleRelativeWeeks
 	ruleRelativeMonth
 	ruleRelativeYear
@@ -34,17 +71,28 @@ const (
 	ruleMinutes
 	ruleSeconds
 	ruleNumber
+	ruleYear
+	ruleWeekNumber
+	ruleHolidayName
+	ruleS
+	ruleNth
 	ruleWeekday
+	rulePluralWeekday
 	ruleMonth
 	ruleIn
+	ruleOne
 	ruleLast
 	ruleNext
 	ruleOrdinal
 	ruleWord
 	ruleYEARS
+	ruleQUARTERS
 	ruleMONTHS
 	ruleWEEKS
 	ruleDAYS
+	ruleBUSINESS_DAYS
+	ruleBUSINESS_HOURS
+	ruleBUSINESS_MINUTES
 	ruleHOURS
 	ruleMINUTES
 	ruleYESTERDAY
@@ -58,6 +106,31 @@ const (
 	ruleNEXT
 	ruleIN
 	ruleLAST
+	ruleTHIS
+	ruleTHE
+	ruleOF
+	ruleON
+	ruleAFTER
+	ruleBEFORE
+	ruleSTART
+	ruleEND
+	ruleEOD
+	ruleEVERY
+	ruleOTHER
+	ruleWEEKEND
+	ruleAT
+	ruleFOR
+	ruleFROM
+	ruleBETWEEN
+	ruleTO
+	rulePAST
+	ruleTIMES
+	ruleEXCEPT
+	ruleUNTIL
+	ruleAND
+	ruleNOON
+	ruleMIDNIGHT
+	ruleCOB
 	rule_
 	ruleWhitespace
 	ruleEOL
@@ -70,6 +143,7 @@ const (
 	ruleAction5
 	ruleAction6
 	ruleAction7
+	rulePegText
 	ruleAction8
 	ruleAction9
 	ruleAction10
@@ -107,7 +181,6 @@ const (
 	ruleAction42
 	ruleAction43
 	ruleAction44
-	rulePegText
 	ruleAction45
 	ruleAction46
 	ruleAction47
@@ -141,15 +214,197 @@ const (
 	ruleAction75
 	ruleAction76
 	ruleAction77
+	ruleAction78
+	ruleAction79
+	ruleAction80
+	ruleAction81
+	ruleAction82
+	ruleAction83
+	ruleAction84
+	ruleAction85
+	ruleAction86
+	ruleAction87
+	ruleAction88
+	ruleAction89
+	ruleAction90
+	ruleAction91
+	ruleAction92
+	ruleAction93
+	ruleAction94
+	ruleAction95
+	ruleAction96
+	ruleAction97
+	ruleAction98
+	ruleAction99
+	ruleAction100
+	ruleAction101
+	ruleAction102
+	ruleAction103
+	ruleAction104
+	ruleAction105
+	ruleAction106
+	ruleAction107
+	ruleAction108
+	ruleAction109
+	ruleAction110
+	ruleAction111
+	ruleAction112
+	ruleAction113
+	ruleAction114
+	ruleAction115
+	ruleAction116
+	ruleAction117
+	ruleAction118
+	ruleAction119
+	ruleAction120
+	ruleAction121
+	ruleAction122
+	ruleAction123
+	ruleAction124
+	ruleAction125
+	ruleAction126
+	ruleAction127
+	ruleAction128
+	ruleAction129
+	ruleAction130
+	ruleAction131
+	ruleAction132
+	ruleAction133
+	ruleAction134
+	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
+	ruleAction140
+	ruleAction141
+	ruleAction142
+	ruleAction143
+	ruleAction144
+	ruleAction145
+	ruleAction146
+	ruleAction147
+	ruleAction148
+	ruleAction149
+	ruleAction150
+	ruleAction151
+	ruleAction152
+	ruleAction153
+	ruleAction154
+	ruleAction155
+	ruleAction156
+	ruleAction157
+	ruleAction158
+	ruleAction159
+	ruleAction160
+	ruleAction161
+	ruleAction162
+	ruleAction163
+	ruleAction164
+	ruleAction165
+	ruleAction166
+	ruleAction167
+	ruleAction168
+	ruleAction169
+	ruleAction170
+	ruleAction171
+	ruleAction172
+	ruleAction173
+	ruleAction174
+	ruleAction175
+	ruleAction176
+	ruleAction177
+	ruleAction178
+	ruleAction179
+	ruleAction180
+	ruleAction181
+	ruleAction182
+	ruleAction183
+	ruleAction184
+	ruleAction185
+	ruleAction186
+	ruleAction187
+	ruleAction188
+	ruleAction189
+	ruleAction190
+	ruleAction191
+	ruleAction192
+	ruleAction193
+	ruleAction194
+	ruleAction195
+	ruleAction196
+	ruleAction197
+	ruleAction198
+	ruleAction199
+	ruleAction200
+	ruleAction201
+	ruleAction202
+	ruleAction203
+	ruleAction204
+	ruleAction205
+	ruleAction206
+	ruleAction207
+	ruleAction208
+	ruleAction209
+	ruleAction210
+	ruleAction211
+	ruleAction212
+	ruleAction213
+	ruleAction214
+	ruleAction215
+	ruleAction216
+	ruleAction217
+	ruleAction218
+	ruleAction219
+	ruleAction220
+	ruleAction221
+	ruleAction222
+	ruleAction223
 )
 
 var rul3s = [...]string{
 	"Unknown",
 	"Query",
 	"Expr",
+	"Moment",
+	"EventDuration",
+	"EventLength",
+	"EventTimes",
+	"EventStart",
+	"EventEnd",
+	"EventTime",
+	"BareHour",
+	"EventWord",
+	"Text",
+	"Recurrence",
+	"UntilMonth",
+	"Limit",
+	"MinutesPast",
+	"Months",
+	"Exclusion",
+	"Schedule",
+	"Interval",
+	"RecurrenceUnit",
+	"Frequency",
+	"Weekdays",
+	"MonthDays",
+	"MonthDay",
+	"And",
+	"Boundary",
+	"Period",
+	"Unit",
+	"Holiday",
+	"Week",
+	"NthWeekday",
+	"NthDay",
 	"RelativeMinutes",
 	"RelativeHours",
 	"RelativeDays",
+	"Offset",
+	"WeekdayInWeek",
+	"RelativeWeek",
+	"RelativeBusinessDays",
+	"RelativeBusinessHours",
 	"RelativeWeeks",
 	"RelativeMonth",
 	"RelativeYear",
@@ -161,17 +416,28 @@ var rul3s = [...]string{
 	"Minutes",
 	"Seconds",
 	"Number",
+	"Year",
+	"WeekNumber",
+	"HolidayName",
+	"S",
+	"Nth",
 	"Weekday",
+	"PluralWeekday",
 	"Month",
 	"In",
+	"One",
 	"Last",
 	"Next",
 	"Ordinal",
 	"Word",
 	"YEARS",
+	"QUARTERS",
 	"MONTHS",
 	"WEEKS",
 	"DAYS",
+	"BUSINESS_DAYS",
+	"BUSINESS_HOURS",
+	"BUSINESS_MINUTES",
 	"HOURS",
 	"MINUTES",
 	"YESTERDAY",
@@ -185,6 +451,31 @@ var rul3s = [...]string{
 	"NEXT",
 	"IN",
 	"LAST",
+	"THIS",
+	"THE",
+	"OF",
+	"ON",
+	"AFTER",
+	"BEFORE",
+	"START",
+	"END",
+	"EOD",
+	"EVERY",
+	"OTHER",
+	"WEEKEND",
+	"AT",
+	"FOR",
+	"FROM",
+	"BETWEEN",
+	"TO",
+	"PAST",
+	"TIMES",
+	"EXCEPT",
+	"UNTIL",
+	"AND",
+	"NOON",
+	"MIDNIGHT",
+	"COB",
 	"_",
 	"Whitespace",
 	"EOL",
@@ -197,6 +488,7 @@ var rul3s = [...]string{
 	"Action5",
 	"Action6",
 	"Action7",
+	"PegText",
 	"Action8",
 	"Action9",
 	"Action10",
@@ -234,7 +526,6 @@ var rul3s = [...]string{
 	"Action42",
 	"Action43",
 	"Action44",
-	"PegText",
 	"Action45",
 	"Action46",
 	"Action47",
@@ -268,6 +559,152 @@ var rul3s = [...]string{
 	"Action75",
 	"Action76",
 	"Action77",
+	"Action78",
+	"Action79",
+	"Action80",
+	"Action81",
+	"Action82",
+	"Action83",
+	"Action84",
+	"Action85",
+	"Action86",
+	"Action87",
+	"Action88",
+	"Action89",
+	"Action90",
+	"Action91",
+	"Action92",
+	"Action93",
+	"Action94",
+	"Action95",
+	"Action96",
+	"Action97",
+	"Action98",
+	"Action99",
+	"Action100",
+	"Action101",
+	"Action102",
+	"Action103",
+	"Action104",
+	"Action105",
+	"Action106",
+	"Action107",
+	"Action108",
+	"Action109",
+	"Action110",
+	"Action111",
+	"Action112",
+	"Action113",
+	"Action114",
+	"Action115",
+	"Action116",
+	"Action117",
+	"Action118",
+	"Action119",
+	"Action120",
+	"Action121",
+	"Action122",
+	"Action123",
+	"Action124",
+	"Action125",
+	"Action126",
+	"Action127",
+	"Action128",
+	"Action129",
+	"Action130",
+	"Action131",
+	"Action132",
+	"Action133",
+	"Action134",
+	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
+	"Action140",
+	"Action141",
+	"Action142",
+	"Action143",
+	"Action144",
+	"Action145",
+	"Action146",
+	"Action147",
+	"Action148",
+	"Action149",
+	"Action150",
+	"Action151",
+	"Action152",
+	"Action153",
+	"Action154",
+	"Action155",
+	"Action156",
+	"Action157",
+	"Action158",
+	"Action159",
+	"Action160",
+	"Action161",
+	"Action162",
+	"Action163",
+	"Action164",
+	"Action165",
+	"Action166",
+	"Action167",
+	"Action168",
+	"Action169",
+	"Action170",
+	"Action171",
+	"Action172",
+	"Action173",
+	"Action174",
+	"Action175",
+	"Action176",
+	"Action177",
+	"Action178",
+	"Action179",
+	"Action180",
+	"Action181",
+	"Action182",
+	"Action183",
+	"Action184",
+	"Action185",
+	"Action186",
+	"Action187",
+	"Action188",
+	"Action189",
+	"Action190",
+	"Action191",
+	"Action192",
+	"Action193",
+	"Action194",
+	"Action195",
+	"Action196",
+	"Action197",
+	"Action198",
+	"Action199",
+	"Action200",
+	"Action201",
+	"Action202",
+	"Action203",
+	"Action204",
+	"Action205",
+	"Action206",
+	"Action207",
+	"Action208",
+	"Action209",
+	"Action210",
+	"Action211",
+	"Action212",
+	"Action213",
+	"Action214",
+	"Action215",
+	"Action216",
+	"Action217",
+	"Action218",
+	"Action219",
+	"Action220",
+	"Action221",
+	"Action222",
+	"Action223",
 }
 
 type token32 struct {
@@ -296,7 +733,7 @@ func (node *node32) print(w io.Writer, pretty bool, buffer string) {
 			if !pretty {
 				fmt.Fprintf(w, "%v %v\n", rule, quote)
 			} else {
-				fmt.Fprintf(w, "\x1B[34m%v\x1B[m %v\n", rule, quote)
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
 			}
 			if node.up != nil {
 				print(node.up, depth+1)
@@ -380,15 +817,39 @@ func (t *tokens32) Tokens() []token32 {
 }
 
 type parser struct {
-	t         time.Time
-	number    int
-	month     time.Month
-	weekday   time.Weekday
-	direction int
+	t            time.Time
+	number       int
+	month        time.Month
+	weekday      time.Weekday
+	direction    int
+	day          int
+	lenient      bool
+	overflow     MonthOverflow
+	period       period
+	weekStart    time.Weekday
+	year         int
+	week         int
+	later        []func()
+	clock        bool
+	holiday      string
+	calendars    []HolidayCalendar
+	weekend      []time.Weekday
+	hours        BusinessHours
+	recurrence   *Recurrence
+	times        []time.Time
+	hour         int
+	hourRangeEnd time.Time
+	until        bool
+	event        bool
+	words        [][2]int
+	from         time.Time
+	meridiem     bool
+	duration     time.Duration
+	err          error
 
 	Buffer string
 	buffer []rune
-	rules  [124]func() bool
+	rules  [342]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -475,6 +936,12 @@ func (p *parser) WriteSyntaxTree(w io.Writer) {
 	p.tokens32.WriteSyntaxTree(w, p.Buffer)
 }
 
+func (p *parser) SprintSyntaxTree() string {
+	var bldr strings.Builder
+	p.WriteSyntaxTree(&bldr)
+	return bldr.String()
+}
+
 func (p *parser) Execute() {
 	buffer, _buffer, text, begin, end := p.Buffer, p.buffer, "", 0, 0
 	for _, token := range p.Tokens() {
@@ -485,110 +952,461 @@ func (p *parser) Execute() {
 			text = string(_buffer[begin:end])
 
 		case ruleAction0:
+			p.duration = 0
+		case ruleAction1:
+			p.duration += time.Duration(p.number) * time.Minute
+		case ruleAction2:
+			p.duration += time.Duration(p.number) * time.Hour
+		case ruleAction3:
+			p.duration += time.Duration(p.number) * day
+		case ruleAction4:
+			p.duration += time.Duration(p.number) * week
+		case ruleAction5:
+			p.setEventStart(false)
+		case ruleAction6:
+			p.setEventStart(true)
+		case ruleAction7:
+			p.setEventEnd()
+		case ruleAction8:
+			p.addWord(begin, end)
+		case ruleAction9:
+			p.addWord(begin, end)
+		case ruleAction10:
+			p.setUntil()
+		case ruleAction11:
+			p.setUntilMonth()
+		case ruleAction12:
+			p.setCount(p.number)
+		case ruleAction13:
+			p.addTime()
+		case ruleAction14:
+			p.addTime()
+		case ruleAction15:
+			p.hour = p.t.Hour()
+		case ruleAction16:
+			p.setHourRange(p.hour)
+		case ruleAction17:
+
+			for _, d := range p.businessWeekdays() {
+				p.recurrence.addWeekday(d)
+			}
+
+		case ruleAction18:
+
+			for _, d := range p.weekend {
+				p.recurrence.addWeekday(d)
+			}
+
+		case ruleAction19:
+			p.addMinute(p.number)
+		case ruleAction20:
+			p.addMinute(p.number)
+		case ruleAction21:
+			p.recurrence.addMonth(p.month)
+		case ruleAction22:
+			p.recurrence.addMonth(p.month)
+		case ruleAction23:
+
+			for _, d := range p.weekend {
+				p.excludeWeekday(d)
+			}
+
+		case ruleAction24:
+			p.excludeWeekday(p.weekday)
+		case ruleAction25:
+			p.excludeMonth(p.month)
+		case ruleAction26:
+
+			p.recurrence.Frequency = Weekly
+			p.recurrence.Weekdays = p.businessWeekdays()
+
+		case ruleAction27:
+
+			p.recurrence.Frequency = Weekly
+			p.recurrence.Weekdays = append([]time.Weekday(nil), p.weekend...)
+
+		case ruleAction28:
+
+			p.recurrence.Frequency = Weekly
+
+		case ruleAction29:
+			p.setInterval(2)
+		case ruleAction30:
+			p.setInterval(p.number)
+		case ruleAction31:
+			p.recurrence.Frequency = Minutely
+		case ruleAction32:
+			p.recurrence.Frequency = Hourly
+		case ruleAction33:
+			p.recurrence.Frequency = Daily
+		case ruleAction34:
+			p.recurrence.Frequency = Weekly
+		case ruleAction35:
+			p.recurrence.Frequency = Monthly
+		case ruleAction36:
+			p.recurrence.Frequency = Yearly
+		case ruleAction37:
+			p.recurrence.Frequency = Hourly
+		case ruleAction38:
+			p.recurrence.Frequency = Daily
+		case ruleAction39:
+			p.recurrence.Frequency = Weekly
+		case ruleAction40:
+			p.recurrence.Frequency = Monthly
+		case ruleAction41:
+			p.recurrence.Frequency = Yearly
+		case ruleAction42:
+			p.recurrence.addWeekday(p.weekday)
+		case ruleAction43:
+			p.recurrence.addWeekday(p.weekday)
+		case ruleAction44:
+			p.setMonthDay(p.number)
+		case ruleAction45:
+
+			p.t = startOf(p.t, p.period, p.weekStart)
+
+		case ruleAction46:
+
+			p.t = endOf(p.t, p.period, p.weekStart)
+
+		case ruleAction47:
+
+			p.period = periodDay
+			p.t = endOf(p.t, p.period, p.weekStart)
+
+		case ruleAction48:
+
+			p.setHour(17)
+
+		case ruleAction49:
+
+			p.t = addPeriod(p.t, p.period, -1)
+
+		case ruleAction50:
+
+			p.t = addPeriod(p.t, p.period, 1)
+
+		case ruleAction51:
+
+			p.period = periodDay
+
+		case ruleAction52:
+
+			p.period = periodDay
+			p.t = p.t.Add(-day)
+
+		case ruleAction53:
+
+			p.period = periodDay
+			p.t = p.t.Add(day)
+
+		case ruleAction54:
+			p.period = periodMinute
+		case ruleAction55:
+			p.period = periodHour
+		case ruleAction56:
+			p.period = periodBusinessDay
+		case ruleAction57:
+			p.period = periodDay
+		case ruleAction58:
+			p.period = periodWeek
+		case ruleAction59:
+			p.period = periodMonth
+		case ruleAction60:
+			p.period = periodQuarter
+		case ruleAction61:
+			p.period = periodYear
+		case ruleAction62:
+
+			p.setHoliday(p.holiday, p.holidayYear(p.holiday, -1, true))
+
+		case ruleAction63:
+
+			p.setHoliday(p.holiday, p.holidayYear(p.holiday, 1, true))
+
+		case ruleAction64:
+
+			p.setHoliday(p.holiday, p.t.Year())
+
+		case ruleAction65:
+
+			p.setHoliday(p.holiday, p.year)
+
+		case ruleAction66:
+
+			p.setHoliday(p.holiday, p.holidayYear(p.holiday, p.direction, false))
+
+		case ruleAction67:
+
+			n, _ := strconv.Atoi(text)
+			p.setISOWeek(p.year, p.week)
+			p.t = p.t.AddDate(0, 0, n-1)
+			p.period = periodDay
+
+		case ruleAction68:
+
+			p.setISOWeek(p.year, p.week)
+
+		case ruleAction69:
+
+			p.setISOWeek(p.year, p.week)
+
+		case ruleAction70:
+
+			year, _ := p.t.ISOWeek()
+			p.setISOWeek(year, p.week)
+
+		case ruleAction71:
+
+			p.later = append(p.later, func() {
+				p.period = periodISOWeek
+				p.t = startOf(p.t, p.period, p.weekStart)
+			})
+
+		case ruleAction72:
+
+			n, weekday := p.number, p.weekday
+			p.t = p.truncateDay(p.t)
+			p.later = append(p.later, func() {
+				p.setNthWeekday(n, weekday)
+			})
+
+		case ruleAction73:
+
+			n := p.number
+			p.t = p.truncateDay(p.t)
+			p.later = append(p.later, func() {
+				p.period = periodDay
+				p.t = p.nthBusinessDay(p.t, n)
+			})
+
+		case ruleAction74:
+
+			n := p.number
+			p.t = p.truncateDay(p.t)
+			p.later = append(p.later, func() {
+				p.period = periodDay
+				p.t = nthDay(p.t, n)
+			})
+
+		case ruleAction75:
 
 			p.t = p.t.Add(-time.Minute * time.Duration(p.number))
 
-		case ruleAction1:
+		case ruleAction76:
 
 			p.t = p.t.Add(time.Minute * time.Duration(p.number))
 
-		case ruleAction2:
+		case ruleAction77:
 
 			p.t = p.t.Add(-time.Minute * time.Duration(p.number))
 
-		case ruleAction3:
+		case ruleAction78:
 
 			p.t = p.t.Add(time.Minute * time.Duration(p.number))
 
-		case ruleAction4:
+		case ruleAction79:
+
+			p.period = periodMinute
+
+		case ruleAction80:
 
 			p.t = p.t.Add(p.withDirection(time.Minute) * time.Duration(p.number))
 
-		case ruleAction5:
+		case ruleAction81:
 
 			p.t = p.t.Add(-time.Hour * time.Duration(p.number))
 
-		case ruleAction6:
+		case ruleAction82:
 
 			p.t = p.t.Add(time.Hour * time.Duration(p.number))
 
-		case ruleAction7:
+		case ruleAction83:
 
 			p.t = p.t.Add(-time.Hour * time.Duration(p.number))
 
-		case ruleAction8:
+		case ruleAction84:
 
 			p.t = p.t.Add(time.Hour * time.Duration(p.number))
 
-		case ruleAction9:
+		case ruleAction85:
+
+			p.period = periodHour
+
+		case ruleAction86:
 
 			p.t = p.t.Add(p.withDirection(time.Hour) * time.Duration(p.number))
 
-		case ruleAction10:
+		case ruleAction87:
 
-			p.t = truncateDay(p.t.Add(-day * time.Duration(p.number)))
+			p.t = p.truncateDay(p.t.Add(-day * time.Duration(p.number)))
 
-		case ruleAction11:
+		case ruleAction88:
 
 			p.t = p.t.Add(day * time.Duration(p.number))
 
-		case ruleAction12:
+		case ruleAction89:
 
-			p.t = truncateDay(p.t.Add(-day * time.Duration(p.number)))
+			p.t = p.truncateDay(p.t.Add(-day * time.Duration(p.number)))
 
-		case ruleAction13:
+		case ruleAction90:
 
-			p.t = truncateDay(p.t.Add(day * time.Duration(p.number)))
+			p.t = p.truncateDay(p.t.Add(day * time.Duration(p.number)))
 
-		case ruleAction14:
+		case ruleAction91:
 
-			p.t = truncateDay(p.t.Add(p.withDirection(day) * time.Duration(p.number)))
+			p.period = periodDay
 
-		case ruleAction15:
+		case ruleAction92:
 
-			p.t = truncateDay(p.t.Add(-week * time.Duration(p.number)))
+			p.t = p.truncateDay(p.t.Add(p.withDirection(day) * time.Duration(p.number)))
 
-		case ruleAction16:
+		case ruleAction93:
+
+			n, unit := p.number, p.period
+			p.period = periodNone
+			p.later = append(p.later, func() {
+				p.addPeriod(unit, n)
+			})
+
+		case ruleAction94:
+
+			n, unit := p.number, p.period
+			p.period = periodNone
+			p.later = append(p.later, func() {
+				p.addPeriod(unit, -n)
+			})
+
+		case ruleAction95:
+
+			p.period = periodDay
+			p.t = p.truncateDay(thisWeekday(p.t, p.weekday, p.weekStart))
+
+		case ruleAction96:
+
+			p.period = periodDay
+			p.t = p.truncateDay(thisWeekday(p.t, p.weekday, p.weekStart))
+
+		case ruleAction97:
+
+			p.period = periodDay
+			p.t = p.truncateDay(nextWeekday(p.t, p.weekday).Add(week))
+
+		case ruleAction98:
+			p.t = p.t.Add(-week)
+		case ruleAction99:
+			p.t = p.t.Add(week)
+		case ruleAction100:
+
+			p.t = p.truncateDay(p.addBusinessDays(p.t, -p.number))
+
+		case ruleAction101:
+
+			p.t = p.addBusinessDays(p.t, p.number)
+
+		case ruleAction102:
+
+			p.t = p.truncateDay(p.addBusinessDays(p.t, -p.number))
+
+		case ruleAction103:
+
+			p.t = p.truncateDay(p.addBusinessDays(p.t, p.number))
+
+		case ruleAction104:
+
+			p.t = p.truncateDay(p.addBusinessDays(p.t, p.direction*p.number))
+
+		case ruleAction105:
+
+			p.t = p.addBusinessTime(p.t, -time.Minute*time.Duration(p.number))
+
+		case ruleAction106:
+
+			p.t = p.addBusinessTime(p.t, time.Minute*time.Duration(p.number))
+
+		case ruleAction107:
+
+			p.t = p.addBusinessTime(p.t, p.withDirection(time.Minute)*time.Duration(p.number))
+
+		case ruleAction108:
+
+			p.t = p.addBusinessTime(p.t, -time.Hour*time.Duration(p.number))
+
+		case ruleAction109:
+
+			p.t = p.addBusinessTime(p.t, time.Hour*time.Duration(p.number))
+
+		case ruleAction110:
+
+			p.t = p.addBusinessTime(p.t, p.withDirection(time.Hour)*time.Duration(p.number))
+
+		case ruleAction111:
+
+			p.t = p.truncateDay(p.t.Add(-week * time.Duration(p.number)))
+
+		case ruleAction112:
 
 			p.t = p.t.Add(week * time.Duration(p.number))
 
-		case ruleAction17:
+		case ruleAction113:
 
-			p.t = truncateDay(p.t.Add(-week * time.Duration(p.number)))
+			p.period = periodWeek
+			p.t = startOf(p.t.Add(-week), p.period, p.weekStart)
 
-		case ruleAction18:
+		case ruleAction114:
 
-			p.t = truncateDay(p.t.Add(week * time.Duration(p.number)))
+			p.period = periodWeek
+			p.t = startOf(p.t.Add(week), p.period, p.weekStart)
 
-		case ruleAction19:
+		case ruleAction115:
 
-			p.t = truncateDay(p.t.Add(p.withDirection(week) * time.Duration(p.number)))
+			p.t = p.truncateDay(p.t.Add(-week * time.Duration(p.number)))
 
-		case ruleAction20:
+		case ruleAction116:
 
-			p.t = p.t.AddDate(0, -p.number, 0)
+			p.t = p.truncateDay(p.t.Add(week * time.Duration(p.number)))
 
-		case ruleAction21:
+		case ruleAction117:
 
-			p.t = p.t.AddDate(0, p.number, 0)
+			p.period = periodWeek
 
-		case ruleAction22:
+		case ruleAction118:
 
-			p.t = p.t.AddDate(0, -p.number, 0)
+			p.t = p.truncateDay(p.t.Add(p.withDirection(week) * time.Duration(p.number)))
 
-		case ruleAction23:
+		case ruleAction119:
 
-			p.t = p.t.AddDate(0, p.number, 0)
+			p.addMonths(-p.number)
 
-		case ruleAction24:
+		case ruleAction120:
+
+			p.addMonths(p.number)
+
+		case ruleAction121:
+
+			p.addMonths(-p.number)
+
+		case ruleAction122:
+
+			p.addMonths(p.number)
+
+		case ruleAction123:
+
+			p.period = periodMonth
+
+		case ruleAction124:
 
 			p.t = prevMonth(p.t, p.month)
 
-		case ruleAction25:
+		case ruleAction125:
 
 			p.t = nextMonth(p.t, p.month)
 
-		case ruleAction26:
+		case ruleAction126:
+
+			p.t = thisMonth(p.t, p.month)
+
+		case ruleAction127:
 
 			if p.direction < 0 {
 				p.t = prevMonth(p.t, p.month)
@@ -596,161 +1414,251 @@ func (p *parser) Execute() {
 				p.t = nextMonth(p.t, p.month)
 			}
 
-		case ruleAction27:
+		case ruleAction128:
 
-			p.t = p.t.AddDate(-p.number, 0, 0)
+			p.addMonths(-12 * p.number)
 
-		case ruleAction28:
+		case ruleAction129:
 
-			p.t = p.t.AddDate(p.number, 0, 0)
+			p.addMonths(12 * p.number)
 
-		case ruleAction29:
+		case ruleAction130:
 
-			p.t = p.t.AddDate(-p.number, 0, 0)
+			p.addMonths(-12 * p.number)
 
-		case ruleAction30:
+		case ruleAction131:
 
-			p.t = p.t.AddDate(p.number, 0, 0)
+			p.addMonths(12 * p.number)
 
-		case ruleAction31:
+		case ruleAction132:
+
+			p.period = periodYear
+
+		case ruleAction133:
 
 			p.t = time.Date(p.t.Year()-1, 1, 1, 0, 0, 0, 0, p.t.Location())
 
-		case ruleAction32:
+		case ruleAction134:
 
 			p.t = time.Date(p.t.Year()+1, 1, 1, 0, 0, 0, 0, p.t.Location())
 
-		case ruleAction33:
+		case ruleAction135:
 
-			p.t = truncateDay(p.t)
+			p.t = p.truncateDay(p.t)
 
-		case ruleAction34:
+		case ruleAction136:
 
-			p.t = truncateDay(p.t.Add(-day))
+			p.t = p.truncateDay(p.t.Add(-day))
 
-		case ruleAction35:
+		case ruleAction137:
 
-			p.t = truncateDay(p.t.Add(+day))
+			p.t = p.truncateDay(p.t.Add(+day))
 
-		case ruleAction36:
+		case ruleAction138:
 
-			p.t = truncateDay(prevWeekday(p.t, p.weekday))
+			p.t = p.truncateDay(addWeekday(p.t, p.weekday, -p.number))
 
-		case ruleAction37:
+		case ruleAction139:
 
-			p.t = truncateDay(nextWeekday(p.t, p.weekday))
+			p.t = p.truncateDay(addWeekday(p.t, p.weekday, p.number))
 
-		case ruleAction38:
+		case ruleAction140:
+
+			p.t = p.truncateDay(addWeekday(p.t, p.weekday, p.direction*p.number))
+
+		case ruleAction141:
+
+			p.t = p.truncateDay(prevWeekday(p.t, p.weekday))
+
+		case ruleAction142:
+
+			p.t = p.truncateDay(nextWeekday(p.t, p.weekday))
+
+		case ruleAction143:
+
+			p.t = p.truncateDay(thisWeekday(p.t, p.weekday, p.weekStart))
+
+		case ruleAction144:
 
 			if p.direction < 0 {
-				p.t = truncateDay(prevWeekday(p.t, p.weekday))
+				p.t = p.truncateDay(prevWeekday(p.t, p.weekday))
 			} else {
-				p.t = truncateDay(nextWeekday(p.t, p.weekday))
+				p.t = p.truncateDay(nextWeekday(p.t, p.weekday))
 			}
 
-		case ruleAction39:
+		case ruleAction145:
 
-			t := p.t
-			year, month, _ := t.Date()
-			hour, min, sec := t.Clock()
-			p.t = time.Date(year, month, p.number, hour, min, sec, 0, t.Location())
+			p.setDay(p.number)
 
-		case ruleAction40:
+		case ruleAction146:
+			p.setHour(12)
+		case ruleAction147:
+			p.setHour(0)
+		case ruleAction148:
 
-			year, month, day := p.t.Date()
-			p.t = time.Date(year, month, day, p.number, 0, 0, 0, p.t.Location())
+			p.setHour12(p.number, false)
 
-		case ruleAction41:
+		case ruleAction149:
 
-			year, month, day := p.t.Date()
-			p.t = time.Date(year, month, day, p.number+12, 0, 0, 0, p.t.Location())
+			p.setHour12(p.number, true)
 
-		case ruleAction42:
+		case ruleAction150:
 
-			year, month, day := p.t.Date()
-			p.t = time.Date(year, month, day, p.number, 0, 0, 0, p.t.Location())
+			p.setHour(p.number)
 
-		case ruleAction43:
+		case ruleAction151:
 
-			t := p.t
-			year, month, day := t.Date()
-			hour, _, _ := t.Clock()
-			p.t = time.Date(year, month, day, hour, p.number, 0, 0, t.Location())
+			p.setMinute(p.number)
 
-		case ruleAction44:
+		case ruleAction152:
 
-			t := p.t
-			year, month, day := t.Date()
-			hour, min, _ := t.Clock()
-			p.t = time.Date(year, month, day, hour, min, p.number, 0, t.Location())
+			p.setSecond(p.number)
 
-		case ruleAction45:
+		case ruleAction153:
 			n, _ := strconv.Atoi(text)
 			p.number = n
-		case ruleAction46:
+		case ruleAction154:
 			p.number = 1
-		case ruleAction47:
+		case ruleAction155:
 			p.number = 2
-		case ruleAction48:
+		case ruleAction156:
 			p.number = 3
-		case ruleAction49:
+		case ruleAction157:
 			p.number = 4
-		case ruleAction50:
+		case ruleAction158:
 			p.number = 5
-		case ruleAction51:
+		case ruleAction159:
 			p.number = 6
-		case ruleAction52:
+		case ruleAction160:
 			p.number = 7
-		case ruleAction53:
+		case ruleAction161:
 			p.number = 8
-		case ruleAction54:
+		case ruleAction162:
 			p.number = 9
-		case ruleAction55:
+		case ruleAction163:
 			p.number = 10
-		case ruleAction56:
+		case ruleAction164:
+			n, _ := strconv.Atoi(text)
+			p.year = n
+		case ruleAction165:
+			n, _ := strconv.Atoi(text)
+			p.week = n
+		case ruleAction166:
+			p.holiday = "new year's eve"
+		case ruleAction167:
+			p.holiday = "new year's day"
+		case ruleAction168:
+			p.holiday = "mlk day"
+		case ruleAction169:
+			p.holiday = "valentine's day"
+		case ruleAction170:
+			p.holiday = "presidents day"
+		case ruleAction171:
+			p.holiday = "st patrick's day"
+		case ruleAction172:
+			p.holiday = "good friday"
+		case ruleAction173:
+			p.holiday = "easter monday"
+		case ruleAction174:
+			p.holiday = "easter"
+		case ruleAction175:
+			p.holiday = "mother's day"
+		case ruleAction176:
+			p.holiday = "memorial day"
+		case ruleAction177:
+			p.holiday = "father's day"
+		case ruleAction178:
+			p.holiday = "independence day"
+		case ruleAction179:
+			p.holiday = "labor day"
+		case ruleAction180:
+			p.holiday = "early may bank holiday"
+		case ruleAction181:
+			p.holiday = "spring bank holiday"
+		case ruleAction182:
+			p.holiday = "summer bank holiday"
+		case ruleAction183:
+			p.holiday = "may day"
+		case ruleAction184:
+			p.holiday = "ascension day"
+		case ruleAction185:
+			p.holiday = "whit monday"
+		case ruleAction186:
+			p.holiday = "german unity day"
+		case ruleAction187:
+			p.holiday = "columbus day"
+		case ruleAction188:
+			p.holiday = "halloween"
+		case ruleAction189:
+			p.holiday = "veterans day"
+		case ruleAction190:
+			p.holiday = "thanksgiving"
+		case ruleAction191:
+			p.holiday = "christmas eve"
+		case ruleAction192:
+			p.holiday = "christmas"
+		case ruleAction193:
+			p.holiday = "boxing day"
+		case ruleAction194:
+			p.number = 1
+		case ruleAction195:
+			p.number = 2
+		case ruleAction196:
+			p.number = 3
+		case ruleAction197:
+			p.number = 4
+		case ruleAction198:
+			p.number = 5
+		case ruleAction199:
+			p.number = -1
+		case ruleAction200:
 			p.weekday = time.Sunday
-		case ruleAction57:
+		case ruleAction201:
 			p.weekday = time.Monday
-		case ruleAction58:
+		case ruleAction202:
 			p.weekday = time.Tuesday
-		case ruleAction59:
+		case ruleAction203:
 			p.weekday = time.Wednesday
-		case ruleAction60:
+		case ruleAction204:
 			p.weekday = time.Thursday
-		case ruleAction61:
+		case ruleAction205:
 			p.weekday = time.Friday
-		case ruleAction62:
+		case ruleAction206:
 			p.weekday = time.Saturday
-		case ruleAction63:
+		case ruleAction207:
 			p.month = time.January
-		case ruleAction64:
+		case ruleAction208:
 			p.month = time.February
-		case ruleAction65:
+		case ruleAction209:
 			p.month = time.March
-		case ruleAction66:
+		case ruleAction210:
 			p.month = time.April
-		case ruleAction67:
+		case ruleAction211:
 			p.month = time.May
-		case ruleAction68:
+		case ruleAction212:
 			p.month = time.June
-		case ruleAction69:
+		case ruleAction213:
 			p.month = time.July
-		case ruleAction70:
+		case ruleAction214:
 			p.month = time.August
-		case ruleAction71:
+		case ruleAction215:
 			p.month = time.September
-		case ruleAction72:
+		case ruleAction216:
 			p.month = time.October
-		case ruleAction73:
+		case ruleAction217:
 			p.month = time.November
-		case ruleAction74:
+		case ruleAction218:
 			p.month = time.December
-		case ruleAction75:
+		case ruleAction219:
 			p.number = 1
-		case ruleAction76:
+		case ruleAction220:
 			p.number = 1
-		case ruleAction77:
+		case ruleAction221:
+			p.number = 1
+		case ruleAction222:
 			p.number = 1
+		case ruleAction223:
+			p.addWord(begin, end)
 
 		}
 	}
@@ -844,2522 +1752,1663 @@ func (p *parser) Init(options ...func(*parser) error) error {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 Query <- <(_ Expr+ EOF)> */
+		/* 0 Query <- <((&{ p.recurrence == nil && !p.event } _ Expr+ EOF) / (&{ p.recurrence != nil } _ Recurrence EOF) / (&{ p.event } _ (EventDuration / EventTimes / EventTime / EventWord / Moment / Word / Text)+ EOF))> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
 				position1 := position
-				if !_rules[rule_]() {
-					goto l0
-				}
 				{
-					position4 := position
+					position2, tokenIndex2 := position, tokenIndex
+					if !(p.recurrence == nil && !p.event) {
+						goto l3
+					}
+					if !_rules[rule_]() {
+						goto l3
+					}
+					if !_rules[ruleExpr]() {
+						goto l3
+					}
+				l4:
 					{
 						position5, tokenIndex5 := position, tokenIndex
-						{
-							position7 := position
-							if buffer[position] != rune('n') {
-								goto l6
-							}
-							position++
-							if buffer[position] != rune('o') {
-								goto l6
-							}
-							position++
-							if buffer[position] != rune('w') {
-								goto l6
-							}
-							position++
-							if !_rules[rule_]() {
-								goto l6
-							}
-							add(ruleNOW, position7)
+						if !_rules[ruleExpr]() {
+							goto l5
 						}
-						goto l5
-					l6:
+						goto l4
+					l5:
 						position, tokenIndex = position5, tokenIndex5
+					}
+					if !_rules[ruleEOF]() {
+						goto l3
+					}
+					goto l2
+				l3:
+					position, tokenIndex = position2, tokenIndex2
+					if !(p.recurrence != nil) {
+						goto l6
+					}
+					if !_rules[rule_]() {
+						goto l6
+					}
+					{
+						position7 := position
 						{
-							position9 := position
+							position8, tokenIndex8 := position, tokenIndex
 							{
-								position10, tokenIndex10 := position, tokenIndex
-								if !_rules[ruleNumber]() {
-									goto l11
+								position10 := position
+								if buffer[position] != rune('e') {
+									goto l9
 								}
-								if !_rules[ruleMINUTES]() {
-									goto l11
+								position++
+								if buffer[position] != rune('v') {
+									goto l9
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l9
 								}
-								if !_rules[ruleAGO]() {
-									goto l11
+								position++
+								if buffer[position] != rune('r') {
+									goto l9
 								}
-								{
-									add(ruleAction0, position)
+								position++
+								if buffer[position] != rune('y') {
+									goto l9
+								}
+								position++
+								if !_rules[rule_]() {
+									goto l9
 								}
-								goto l10
-							l11:
-								position, tokenIndex = position10, tokenIndex10
+								add(ruleEVERY, position10)
+							}
+							{
+								position11 := position
 								{
-									position14, tokenIndex14 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l15
+									position12, tokenIndex12 := position, tokenIndex
+									if !_rules[ruleBUSINESS_DAYS]() {
+										goto l13
 									}
-									if !_rules[ruleMINUTES]() {
-										goto l15
+									{
+										add(ruleAction26, position)
 									}
-									if !_rules[ruleFROM_NOW]() {
+									goto l12
+								l13:
+									position, tokenIndex = position12, tokenIndex12
+									if !_rules[ruleWEEKEND]() {
 										goto l15
 									}
-									goto l14
+									{
+										add(ruleAction27, position)
+									}
+									goto l12
 								l15:
-									position, tokenIndex = position14, tokenIndex14
-									if !_rules[ruleIn]() {
-										goto l13
+									position, tokenIndex = position12, tokenIndex12
+									if !_rules[ruleWeekdays]() {
+										goto l17
 									}
 									{
-										position16, tokenIndex16 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l16
-										}
-										goto l17
-									l16:
-										position, tokenIndex = position16, tokenIndex16
+										add(ruleAction28, position)
 									}
+									goto l12
 								l17:
-									if !_rules[ruleMINUTES]() {
-										goto l13
-									}
+									position, tokenIndex = position12, tokenIndex12
 									{
-										position18, tokenIndex18 := position, tokenIndex
-										if !_rules[ruleFROM_NOW]() {
-											goto l18
+										position19, tokenIndex19 := position, tokenIndex
+										{
+											position21 := position
+											{
+												position22, tokenIndex22 := position, tokenIndex
+												{
+													position24 := position
+													if buffer[position] != rune('o') {
+														goto l23
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l23
+													}
+													position++
+													if buffer[position] != rune('h') {
+														goto l23
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l23
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l23
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l23
+													}
+													add(ruleOTHER, position24)
+												}
+												{
+													add(ruleAction29, position)
+												}
+												goto l22
+											l23:
+												position, tokenIndex = position22, tokenIndex22
+												if !_rules[ruleNumber]() {
+													goto l19
+												}
+												{
+													add(ruleAction30, position)
+												}
+											}
+										l22:
+											add(ruleInterval, position21)
 										}
-										goto l19
-									l18:
-										position, tokenIndex = position18, tokenIndex18
-									}
-								l19:
-								}
-							l14:
-								{
-									add(ruleAction1, position)
-								}
-								goto l10
-							l13:
-								position, tokenIndex = position10, tokenIndex10
-								if !_rules[ruleLast]() {
-									goto l21
-								}
-								{
-									position22, tokenIndex22 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l22
-									}
-									goto l23
-								l22:
-									position, tokenIndex = position22, tokenIndex22
-								}
-							l23:
-								if !_rules[ruleMINUTES]() {
-									goto l21
-								}
-								{
-									add(ruleAction2, position)
-								}
-								goto l10
-							l21:
-								position, tokenIndex = position10, tokenIndex10
-								if !_rules[ruleNext]() {
-									goto l25
-								}
-								{
-									position26, tokenIndex26 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l26
-									}
-									goto l27
-								l26:
-									position, tokenIndex = position26, tokenIndex26
-								}
-							l27:
-								if !_rules[ruleMINUTES]() {
-									goto l25
-								}
-								{
-									add(ruleAction3, position)
-								}
-								goto l10
-							l25:
-								position, tokenIndex = position10, tokenIndex10
-								if !_rules[ruleNumber]() {
-									goto l8
-								}
-								if !_rules[ruleMINUTES]() {
-									goto l8
-								}
-								{
-									add(ruleAction4, position)
-								}
-							}
-						l10:
-							add(ruleRelativeMinutes, position9)
-						}
-						goto l5
-					l8:
-						position, tokenIndex = position5, tokenIndex5
-						{
-							position31 := position
-							{
-								position32, tokenIndex32 := position, tokenIndex
-								if !_rules[ruleNumber]() {
-									goto l33
-								}
-								if !_rules[ruleHOURS]() {
-									goto l33
-								}
-								if !_rules[ruleAGO]() {
-									goto l33
-								}
-								{
-									add(ruleAction5, position)
-								}
-								goto l32
-							l33:
-								position, tokenIndex = position32, tokenIndex32
-								{
-									position36, tokenIndex36 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l37
-									}
-									if !_rules[ruleHOURS]() {
-										goto l37
-									}
-									if !_rules[ruleFROM_NOW]() {
-										goto l37
-									}
-									goto l36
-								l37:
-									position, tokenIndex = position36, tokenIndex36
-									if !_rules[ruleIn]() {
-										goto l35
+										goto l20
+									l19:
+										position, tokenIndex = position19, tokenIndex19
 									}
+								l20:
 									{
-										position38, tokenIndex38 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l38
+										position27 := position
+										{
+											position28, tokenIndex28 := position, tokenIndex
+											if !_rules[ruleMINUTES]() {
+												goto l29
+											}
+											{
+												add(ruleAction31, position)
+											}
+											goto l28
+										l29:
+											position, tokenIndex = position28, tokenIndex28
+											{
+												switch buffer[position] {
+												case 'y':
+													if !_rules[ruleYEARS]() {
+														goto l9
+													}
+													{
+														add(ruleAction36, position)
+													}
+												case 'm':
+													if !_rules[ruleMONTHS]() {
+														goto l9
+													}
+													{
+														add(ruleAction35, position)
+													}
+												case 'w':
+													if !_rules[ruleWEEKS]() {
+														goto l9
+													}
+													{
+														add(ruleAction34, position)
+													}
+												case 'd':
+													if !_rules[ruleDAYS]() {
+														goto l9
+													}
+													{
+														add(ruleAction33, position)
+													}
+												default:
+													if !_rules[ruleHOURS]() {
+														goto l9
+													}
+													{
+														add(ruleAction32, position)
+													}
+												}
+											}
+
 										}
-										goto l39
-									l38:
-										position, tokenIndex = position38, tokenIndex38
-									}
-								l39:
-									if !_rules[ruleHOURS]() {
-										goto l35
+									l28:
+										add(ruleRecurrenceUnit, position27)
 									}
 									{
-										position40, tokenIndex40 := position, tokenIndex
-										if !_rules[ruleFROM_NOW]() {
-											goto l40
+										position37, tokenIndex37 := position, tokenIndex
+										if !_rules[ruleON]() {
+											goto l37
 										}
-										goto l41
-									l40:
-										position, tokenIndex = position40, tokenIndex40
-									}
-								l41:
-								}
-							l36:
-								{
-									add(ruleAction6, position)
-								}
-								goto l32
-							l35:
-								position, tokenIndex = position32, tokenIndex32
-								if !_rules[ruleLast]() {
-									goto l43
-								}
-								{
-									position44, tokenIndex44 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l44
-									}
-									goto l45
-								l44:
-									position, tokenIndex = position44, tokenIndex44
-								}
-							l45:
-								if !_rules[ruleHOURS]() {
-									goto l43
-								}
-								{
-									add(ruleAction7, position)
-								}
-								goto l32
-							l43:
-								position, tokenIndex = position32, tokenIndex32
-								if !_rules[ruleNext]() {
-									goto l47
-								}
-								{
-									position48, tokenIndex48 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l48
+										{
+											position39, tokenIndex39 := position, tokenIndex
+											if !_rules[ruleWeekdays]() {
+												goto l40
+											}
+											goto l39
+										l40:
+											position, tokenIndex = position39, tokenIndex39
+											{
+												position41, tokenIndex41 := position, tokenIndex
+												if !_rules[ruleTHE]() {
+													goto l41
+												}
+												goto l42
+											l41:
+												position, tokenIndex = position41, tokenIndex41
+											}
+										l42:
+											if !_rules[ruleMonthDays]() {
+												goto l37
+											}
+										}
+									l39:
+										goto l38
+									l37:
+										position, tokenIndex = position37, tokenIndex37
 									}
-									goto l49
-								l48:
-									position, tokenIndex = position48, tokenIndex48
-								}
-							l49:
-								if !_rules[ruleHOURS]() {
-									goto l47
-								}
-								{
-									add(ruleAction8, position)
-								}
-								goto l32
-							l47:
-								position, tokenIndex = position32, tokenIndex32
-								if !_rules[ruleNumber]() {
-									goto l30
-								}
-								if !_rules[ruleHOURS]() {
-									goto l30
-								}
-								{
-									add(ruleAction9, position)
+								l38:
 								}
+							l12:
+								add(ruleSchedule, position11)
 							}
-						l32:
-							add(ruleRelativeHours, position31)
-						}
-						goto l5
-					l30:
-						position, tokenIndex = position5, tokenIndex5
-						{
-							position53 := position
+							goto l8
+						l9:
+							position, tokenIndex = position8, tokenIndex8
 							{
-								position54, tokenIndex54 := position, tokenIndex
-								if !_rules[ruleNumber]() {
-									goto l55
-								}
-								if !_rules[ruleDAYS]() {
-									goto l55
-								}
-								if !_rules[ruleAGO]() {
-									goto l55
-								}
-								{
-									add(ruleAction10, position)
-								}
-								goto l54
-							l55:
-								position, tokenIndex = position54, tokenIndex54
+								position43 := position
 								{
-									position58, tokenIndex58 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l59
-									}
-									if !_rules[ruleDAYS]() {
-										goto l59
-									}
-									if !_rules[ruleFROM_NOW]() {
-										goto l59
-									}
-									goto l58
-								l59:
-									position, tokenIndex = position58, tokenIndex58
-									if !_rules[ruleIn]() {
-										goto l57
-									}
-									{
-										position60, tokenIndex60 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l60
+									switch buffer[position] {
+									case 'm':
+										if buffer[position] != rune('m') {
+											goto l6
 										}
-										goto l61
-									l60:
-										position, tokenIndex = position60, tokenIndex60
-									}
-								l61:
-									if !_rules[ruleDAYS]() {
-										goto l57
-									}
-									{
-										position62, tokenIndex62 := position, tokenIndex
-										if !_rules[ruleFROM_NOW]() {
-											goto l62
+										position++
+										if buffer[position] != rune('o') {
+											goto l6
 										}
-										goto l63
-									l62:
-										position, tokenIndex = position62, tokenIndex62
-									}
-								l63:
-								}
-							l58:
-								{
-									add(ruleAction11, position)
-								}
-								goto l54
-							l57:
-								position, tokenIndex = position54, tokenIndex54
-								if !_rules[ruleLast]() {
-									goto l65
-								}
-								{
-									position66, tokenIndex66 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l66
-									}
-									goto l67
-								l66:
-									position, tokenIndex = position66, tokenIndex66
-								}
-							l67:
-								if !_rules[ruleDAYS]() {
-									goto l65
-								}
-								{
-									add(ruleAction12, position)
-								}
-								goto l54
-							l65:
-								position, tokenIndex = position54, tokenIndex54
-								if !_rules[ruleNext]() {
-									goto l69
-								}
-								{
-									position70, tokenIndex70 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l70
-									}
-									goto l71
-								l70:
-									position, tokenIndex = position70, tokenIndex70
-								}
-							l71:
-								if !_rules[ruleDAYS]() {
-									goto l69
-								}
-								{
-									add(ruleAction13, position)
-								}
-								goto l54
-							l69:
-								position, tokenIndex = position54, tokenIndex54
-								if !_rules[ruleNumber]() {
-									goto l52
-								}
-								if !_rules[ruleDAYS]() {
-									goto l52
-								}
-								{
-									add(ruleAction14, position)
-								}
-							}
-						l54:
-							add(ruleRelativeDays, position53)
-						}
-						goto l5
-					l52:
-						position, tokenIndex = position5, tokenIndex5
-						{
-							position75 := position
-							{
-								position76, tokenIndex76 := position, tokenIndex
-								if !_rules[ruleNumber]() {
-									goto l77
-								}
-								if !_rules[ruleWEEKS]() {
-									goto l77
-								}
-								if !_rules[ruleAGO]() {
-									goto l77
-								}
-								{
-									add(ruleAction15, position)
-								}
-								goto l76
-							l77:
-								position, tokenIndex = position76, tokenIndex76
-								{
-									position80, tokenIndex80 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l81
-									}
-									if !_rules[ruleWEEKS]() {
-										goto l81
-									}
-									if !_rules[ruleFROM_NOW]() {
-										goto l81
-									}
-									goto l80
-								l81:
-									position, tokenIndex = position80, tokenIndex80
-									if !_rules[ruleIn]() {
-										goto l79
-									}
-									{
-										position82, tokenIndex82 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l82
+										position++
+										if buffer[position] != rune('n') {
+											goto l6
 										}
-										goto l83
-									l82:
-										position, tokenIndex = position82, tokenIndex82
-									}
-								l83:
-									if !_rules[ruleWEEKS]() {
-										goto l79
-									}
-									{
-										position84, tokenIndex84 := position, tokenIndex
-										if !_rules[ruleFROM_NOW]() {
-											goto l84
+										position++
+										if buffer[position] != rune('t') {
+											goto l6
 										}
-										goto l85
-									l84:
-										position, tokenIndex = position84, tokenIndex84
-									}
-								l85:
-								}
-							l80:
-								{
-									add(ruleAction16, position)
-								}
-								goto l76
-							l79:
-								position, tokenIndex = position76, tokenIndex76
-								if !_rules[ruleLast]() {
-									goto l87
-								}
-								{
-									position88, tokenIndex88 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l88
-									}
-									goto l89
-								l88:
-									position, tokenIndex = position88, tokenIndex88
-								}
-							l89:
-								if !_rules[ruleWEEKS]() {
-									goto l87
-								}
-								{
-									add(ruleAction17, position)
-								}
-								goto l76
-							l87:
-								position, tokenIndex = position76, tokenIndex76
-								if !_rules[ruleNext]() {
-									goto l91
-								}
-								{
-									position92, tokenIndex92 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l92
-									}
-									goto l93
-								l92:
-									position, tokenIndex = position92, tokenIndex92
-								}
-							l93:
-								if !_rules[ruleWEEKS]() {
-									goto l91
-								}
-								{
-									add(ruleAction18, position)
-								}
-								goto l76
-							l91:
-								position, tokenIndex = position76, tokenIndex76
-								if !_rules[ruleNumber]() {
-									goto l74
-								}
-								if !_rules[ruleWEEKS]() {
-									goto l74
-								}
-								{
-									add(ruleAction19, position)
-								}
-							}
-						l76:
-							add(ruleRelativeWeeks, position75)
-						}
-						goto l5
-					l74:
-						position, tokenIndex = position5, tokenIndex5
-						{
-							position97 := position
-							{
-								position98, tokenIndex98 := position, tokenIndex
-								{
-									position100 := position
-									if buffer[position] != rune('t') {
-										goto l99
-									}
-									position++
-									if buffer[position] != rune('o') {
-										goto l99
-									}
-									position++
-									if buffer[position] != rune('d') {
-										goto l99
-									}
-									position++
-									if buffer[position] != rune('a') {
-										goto l99
-									}
-									position++
-									if buffer[position] != rune('y') {
-										goto l99
-									}
-									position++
-									if !_rules[rule_]() {
-										goto l99
-									}
-									add(ruleTODAY, position100)
-								}
-								{
-									add(ruleAction33, position)
-								}
-								goto l98
-							l99:
-								position, tokenIndex = position98, tokenIndex98
-								{
-									position103 := position
-									if buffer[position] != rune('t') {
-										goto l102
-									}
-									position++
-									if buffer[position] != rune('o') {
-										goto l102
-									}
-									position++
-									if buffer[position] != rune('m') {
-										goto l102
-									}
-									position++
-									if buffer[position] != rune('o') {
-										goto l102
-									}
-									position++
-									if buffer[position] != rune('r') {
-										goto l102
-									}
-									position++
-									if buffer[position] != rune('r') {
-										goto l102
-									}
-									position++
-									if buffer[position] != rune('o') {
-										goto l102
-									}
-									position++
-									if buffer[position] != rune('w') {
-										goto l102
-									}
-									position++
-									if !_rules[rule_]() {
-										goto l102
-									}
-									add(ruleTOMORROW, position103)
-								}
-								{
-									add(ruleAction35, position)
-								}
-								goto l98
-							l102:
-								position, tokenIndex = position98, tokenIndex98
-								{
-									switch buffer[position] {
-									case 'n':
-										if !_rules[ruleNEXT]() {
-											goto l96
+										position++
+										if buffer[position] != rune('h') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l6
 										}
-										if !_rules[ruleWeekday]() {
-											goto l96
+										position++
+										if buffer[position] != rune('y') {
+											goto l6
+										}
+										position++
+										if !_rules[rule_]() {
+											goto l6
+										}
+										{
+											add(ruleAction40, position)
+										}
+									case 'w':
+										if buffer[position] != rune('w') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('k') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('y') {
+											goto l6
+										}
+										position++
+										if !_rules[rule_]() {
+											goto l6
+										}
+										{
+											add(ruleAction39, position)
+										}
+									case 'd':
+										if buffer[position] != rune('d') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('i') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('y') {
+											goto l6
+										}
+										position++
+										if !_rules[rule_]() {
+											goto l6
+										}
+										{
+											add(ruleAction38, position)
+										}
+									case 'h':
+										if buffer[position] != rune('h') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('u') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l6
+										}
+										position++
+										if buffer[position] != rune('y') {
+											goto l6
+										}
+										position++
+										if !_rules[rule_]() {
+											goto l6
 										}
 										{
 											add(ruleAction37, position)
 										}
-										break
-									case 'y':
+									default:
 										{
-											position107 := position
+											position49, tokenIndex49 := position, tokenIndex
 											if buffer[position] != rune('y') {
-												goto l96
+												goto l50
 											}
 											position++
 											if buffer[position] != rune('e') {
-												goto l96
+												goto l50
 											}
 											position++
-											if buffer[position] != rune('s') {
-												goto l96
+											if buffer[position] != rune('a') {
+												goto l50
 											}
 											position++
-											if buffer[position] != rune('t') {
-												goto l96
+											if buffer[position] != rune('r') {
+												goto l50
 											}
 											position++
-											if buffer[position] != rune('e') {
-												goto l96
+											if buffer[position] != rune('l') {
+												goto l50
 											}
 											position++
-											if buffer[position] != rune('r') {
-												goto l96
+											if buffer[position] != rune('y') {
+												goto l50
+											}
+											position++
+											goto l49
+										l50:
+											position, tokenIndex = position49, tokenIndex49
+											if buffer[position] != rune('a') {
+												goto l6
 											}
 											position++
-											if buffer[position] != rune('d') {
-												goto l96
+											if buffer[position] != rune('n') {
+												goto l6
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l6
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l6
 											}
 											position++
 											if buffer[position] != rune('a') {
-												goto l96
+												goto l6
 											}
 											position++
-											if buffer[position] != rune('y') {
-												goto l96
+											if buffer[position] != rune('l') {
+												goto l6
 											}
 											position++
-											if !_rules[rule_]() {
-												goto l96
+											if buffer[position] != rune('l') {
+												goto l6
 											}
-											add(ruleYESTERDAY, position107)
-										}
-										{
-											add(ruleAction34, position)
-										}
-										break
-									case 'l', 'p':
-										if !_rules[ruleLAST]() {
-											goto l96
-										}
-										if !_rules[ruleWeekday]() {
-											goto l96
-										}
-										{
-											add(ruleAction36, position)
+											position++
+											if buffer[position] != rune('y') {
+												goto l6
+											}
+											position++
 										}
-										break
-									default:
-										if !_rules[ruleWeekday]() {
-											goto l96
+									l49:
+										if !_rules[rule_]() {
+											goto l6
 										}
 										{
-											add(ruleAction38, position)
+											add(ruleAction41, position)
 										}
-										break
 									}
 								}
 
+								add(ruleFrequency, position43)
 							}
-						l98:
-							add(ruleRelativeWeekdays, position97)
-						}
-						goto l5
-					l96:
-						position, tokenIndex = position5, tokenIndex5
-						{
-							position112 := position
 							{
-								position113, tokenIndex113 := position, tokenIndex
-								if !_rules[ruleNumber]() {
-									goto l114
-								}
-								if !_rules[ruleMONTHS]() {
-									goto l114
-								}
-								if !_rules[ruleAGO]() {
-									goto l114
+								position52, tokenIndex52 := position, tokenIndex
+								if !_rules[ruleON]() {
+									goto l52
 								}
-								{
-									add(ruleAction20, position)
+								if !_rules[ruleWeekdays]() {
+									goto l52
 								}
-								goto l113
-							l114:
-								position, tokenIndex = position113, tokenIndex113
+								goto l53
+							l52:
+								position, tokenIndex = position52, tokenIndex52
+							}
+						l53:
+						}
+					l8:
+					l54:
+						{
+							position55, tokenIndex55 := position, tokenIndex
+							{
+								position56 := position
 								{
-									position117, tokenIndex117 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l118
-									}
-									if !_rules[ruleMONTHS]() {
-										goto l118
-									}
-									if !_rules[ruleFROM_NOW]() {
-										goto l118
-									}
-									goto l117
-								l118:
-									position, tokenIndex = position117, tokenIndex117
-									if !_rules[ruleIn]() {
-										goto l116
-									}
-									{
-										position119, tokenIndex119 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l119
-										}
-										goto l120
-									l119:
-										position, tokenIndex = position119, tokenIndex119
-									}
-								l120:
-									if !_rules[ruleMONTHS]() {
-										goto l116
-									}
+									position57, tokenIndex57 := position, tokenIndex
 									{
-										position121, tokenIndex121 := position, tokenIndex
-										if !_rules[ruleFROM_NOW]() {
-											goto l121
+										position59, tokenIndex59 := position, tokenIndex
+										if !_rules[ruleFOR]() {
+											goto l59
 										}
-										goto l122
-									l121:
-										position, tokenIndex = position121, tokenIndex121
+										goto l60
+									l59:
+										position, tokenIndex = position59, tokenIndex59
 									}
-								l122:
-								}
-							l117:
-								{
-									add(ruleAction21, position)
-								}
-								goto l113
-							l116:
-								position, tokenIndex = position113, tokenIndex113
-								if !_rules[ruleLast]() {
-									goto l124
-								}
-								{
-									position125, tokenIndex125 := position, tokenIndex
+								l60:
 									if !_rules[ruleNumber]() {
-										goto l125
+										goto l58
 									}
-									goto l126
-								l125:
-									position, tokenIndex = position125, tokenIndex125
-								}
-							l126:
-								if !_rules[ruleMONTHS]() {
-									goto l124
-								}
-								{
-									add(ruleAction22, position)
-								}
-								goto l113
-							l124:
-								position, tokenIndex = position113, tokenIndex113
-								if !_rules[ruleNext]() {
-									goto l128
-								}
-								{
-									position129, tokenIndex129 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l129
-									}
-									goto l130
-								l129:
-									position, tokenIndex = position129, tokenIndex129
-								}
-							l130:
-								if !_rules[ruleMONTHS]() {
-									goto l128
-								}
-								{
-									add(ruleAction23, position)
-								}
-								goto l113
-							l128:
-								position, tokenIndex = position113, tokenIndex113
-								if !_rules[ruleLAST]() {
-									goto l132
-								}
-								if !_rules[ruleMonth]() {
-									goto l132
-								}
-								{
-									add(ruleAction24, position)
-								}
-								goto l113
-							l132:
-								position, tokenIndex = position113, tokenIndex113
-								if !_rules[ruleNEXT]() {
-									goto l134
-								}
-								if !_rules[ruleMonth]() {
-									goto l134
-								}
-								{
-									add(ruleAction25, position)
-								}
-								goto l113
-							l134:
-								position, tokenIndex = position113, tokenIndex113
-								if !_rules[ruleMonth]() {
-									goto l111
-								}
-								{
-									add(ruleAction26, position)
-								}
-							}
-						l113:
-							add(ruleRelativeMonth, position112)
-						}
-						goto l5
-					l111:
-						position, tokenIndex = position5, tokenIndex5
-						{
-							position138 := position
-							{
-								position139, tokenIndex139 := position, tokenIndex
-								if !_rules[ruleNumber]() {
-									goto l140
-								}
-								if !_rules[ruleYEARS]() {
-									goto l140
-								}
-								if !_rules[ruleAGO]() {
-									goto l140
-								}
-								{
-									add(ruleAction27, position)
-								}
-								goto l139
-							l140:
-								position, tokenIndex = position139, tokenIndex139
-								{
-									position143, tokenIndex143 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l144
-									}
-									if !_rules[ruleYEARS]() {
-										goto l144
-									}
-									if !_rules[ruleFROM_NOW]() {
-										goto l144
-									}
-									goto l143
-								l144:
-									position, tokenIndex = position143, tokenIndex143
-									if !_rules[ruleIn]() {
-										goto l142
-									}
-									{
-										position145, tokenIndex145 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l145
-										}
-										goto l146
-									l145:
-										position, tokenIndex = position145, tokenIndex145
-									}
-								l146:
-									if !_rules[ruleYEARS]() {
-										goto l142
-									}
-									{
-										position147, tokenIndex147 := position, tokenIndex
-										if !_rules[ruleFROM_NOW]() {
-											goto l147
-										}
-										goto l148
-									l147:
-										position, tokenIndex = position147, tokenIndex147
-									}
-								l148:
-								}
-							l143:
-								{
-									add(ruleAction28, position)
-								}
-								goto l139
-							l142:
-								position, tokenIndex = position139, tokenIndex139
-								if !_rules[ruleLast]() {
-									goto l150
-								}
-								{
-									position151, tokenIndex151 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l151
-									}
-									goto l152
-								l151:
-									position, tokenIndex = position151, tokenIndex151
-								}
-							l152:
-								if !_rules[ruleYEARS]() {
-									goto l150
-								}
-								{
-									add(ruleAction29, position)
-								}
-								goto l139
-							l150:
-								position, tokenIndex = position139, tokenIndex139
-								if !_rules[ruleNext]() {
-									goto l154
-								}
-								{
-									position155, tokenIndex155 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l155
-									}
-									goto l156
-								l155:
-									position, tokenIndex = position155, tokenIndex155
-								}
-							l156:
-								if !_rules[ruleYEARS]() {
-									goto l154
-								}
-								{
-									add(ruleAction30, position)
-								}
-								goto l139
-							l154:
-								position, tokenIndex = position139, tokenIndex139
-								if !_rules[ruleLAST]() {
-									goto l158
-								}
-								if !_rules[ruleYEARS]() {
-									goto l158
-								}
-								{
-									add(ruleAction31, position)
-								}
-								goto l139
-							l158:
-								position, tokenIndex = position139, tokenIndex139
-								if !_rules[ruleNEXT]() {
-									goto l137
-								}
-								if !_rules[ruleYEARS]() {
-									goto l137
-								}
-								{
-									add(ruleAction32, position)
-								}
-							}
-						l139:
-							add(ruleRelativeYear, position138)
-						}
-						goto l5
-					l137:
-						position, tokenIndex = position5, tokenIndex5
-						{
-							position162 := position
-							{
-								position163, tokenIndex163 := position, tokenIndex
-								if !_rules[ruleNumber]() {
-									goto l164
-								}
-								{
-									position165 := position
 									{
-										switch buffer[position] {
-										case 't':
+										position61 := position
+										{
+											position62, tokenIndex62 := position, tokenIndex
 											if buffer[position] != rune('t') {
-												goto l164
+												goto l63
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l63
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l63
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l63
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l63
+											}
+											position++
+											goto l62
+										l63:
+											position, tokenIndex = position62, tokenIndex62
+											if buffer[position] != rune('o') {
+												goto l58
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l58
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l58
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l58
 											}
 											position++
-											if buffer[position] != rune('h') {
-												goto l164
+											if buffer[position] != rune('r') {
+												goto l58
 											}
 											position++
-											break
-										case 'r':
 											if buffer[position] != rune('r') {
-												goto l164
+												goto l58
 											}
 											position++
-											if buffer[position] != rune('d') {
-												goto l164
+											if buffer[position] != rune('e') {
+												goto l58
 											}
 											position++
-											break
-										case 'n':
 											if buffer[position] != rune('n') {
-												goto l164
+												goto l58
 											}
 											position++
-											if buffer[position] != rune('d') {
-												goto l164
+											if buffer[position] != rune('c') {
+												goto l58
 											}
 											position++
-											break
-										default:
-											if buffer[position] != rune('s') {
-												goto l164
+											if buffer[position] != rune('e') {
+												goto l58
 											}
 											position++
-											if buffer[position] != rune('t') {
-												goto l164
+											if buffer[position] != rune('s') {
+												goto l58
 											}
 											position++
-											break
 										}
+									l62:
+										if !_rules[rule_]() {
+											goto l58
+										}
+										add(ruleTIMES, position61)
 									}
-
-									if !_rules[rule_]() {
-										goto l164
+									{
+										add(ruleAction12, position)
 									}
-									add(ruleOrdinal, position165)
-								}
-								goto l163
-							l164:
-								position, tokenIndex = position163, tokenIndex163
-								if !_rules[ruleLast]() {
-									goto l161
-								}
-								{
-									position167, tokenIndex167 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l167
+									goto l57
+								l58:
+									position, tokenIndex = position57, tokenIndex57
+									{
+										position66, tokenIndex66 := position, tokenIndex
+										if !_rules[ruleAT]() {
+											goto l66
+										}
+										goto l67
+									l66:
+										position, tokenIndex = position66, tokenIndex66
 									}
-									goto l168
-								l167:
-									position, tokenIndex = position167, tokenIndex167
-								}
-							l168:
-								if !_rules[ruleNumber]() {
-									goto l161
-								}
-							}
-						l163:
-							{
-								add(ruleAction39, position)
-							}
-							add(ruleDate, position162)
-						}
-						goto l5
-					l161:
-						position, tokenIndex = position5, tokenIndex5
-						{
-							position171 := position
-							{
-								position172, tokenIndex172 := position, tokenIndex
-								{
-									position174 := position
+								l67:
 									{
-										position175, tokenIndex175 := position, tokenIndex
+										position68 := position
 										if !_rules[ruleNumber]() {
-											goto l176
+											goto l65
 										}
 										{
-											add(ruleAction40, position)
+											add(ruleAction19, position)
 										}
+									l70:
 										{
-											position178, tokenIndex178 := position, tokenIndex
-											if !_rules[ruleMinutes]() {
-												goto l178
+											position71, tokenIndex71 := position, tokenIndex
+											if !_rules[ruleAnd]() {
+												goto l71
+											}
+											if !_rules[ruleNumber]() {
+												goto l71
 											}
 											{
-												position180, tokenIndex180 := position, tokenIndex
-												if !_rules[ruleSeconds]() {
-													goto l180
-												}
-												goto l181
-											l180:
-												position, tokenIndex = position180, tokenIndex180
+												add(ruleAction20, position)
 											}
-										l181:
-											goto l179
-										l178:
-											position, tokenIndex = position178, tokenIndex178
+											goto l70
+										l71:
+											position, tokenIndex = position71, tokenIndex71
+										}
+										if !_rules[ruleMINUTES]() {
+											goto l65
 										}
-									l179:
 										{
-											position182 := position
+											position73 := position
+											if buffer[position] != rune('p') {
+												goto l65
+											}
+											position++
 											if buffer[position] != rune('a') {
-												goto l176
+												goto l65
 											}
 											position++
-											if buffer[position] != rune('m') {
-												goto l176
+											if buffer[position] != rune('s') {
+												goto l65
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l65
 											}
 											position++
 											if !_rules[rule_]() {
-												goto l176
+												goto l65
 											}
-											add(ruleAM, position182)
-										}
-										goto l175
-									l176:
-										position, tokenIndex = position175, tokenIndex175
-										if !_rules[ruleNumber]() {
-											goto l173
+											add(rulePAST, position73)
 										}
 										{
-											add(ruleAction41, position)
-										}
-										{
-											position184, tokenIndex184 := position, tokenIndex
-											if !_rules[ruleMinutes]() {
-												goto l184
-											}
-											{
-												position186, tokenIndex186 := position, tokenIndex
-												if !_rules[ruleSeconds]() {
-													goto l186
-												}
-												goto l187
-											l186:
-												position, tokenIndex = position186, tokenIndex186
+											position74, tokenIndex74 := position, tokenIndex
+											if !_rules[ruleTHE]() {
+												goto l74
 											}
-										l187:
-											goto l185
-										l184:
-											position, tokenIndex = position184, tokenIndex184
+											goto l75
+										l74:
+											position, tokenIndex = position74, tokenIndex74
 										}
-									l185:
-										{
-											position188 := position
-											if buffer[position] != rune('p') {
-												goto l173
-											}
-											position++
-											if buffer[position] != rune('m') {
-												goto l173
-											}
-											position++
-											if !_rules[rule_]() {
-												goto l173
-											}
-											add(rulePM, position188)
+									l75:
+										if !_rules[ruleHOURS]() {
+											goto l65
 										}
+										add(ruleMinutesPast, position68)
 									}
-								l175:
-									add(ruleClock12Hour, position174)
-								}
-								goto l172
-							l173:
-								position, tokenIndex = position172, tokenIndex172
-								{
-									position189 := position
-									if !_rules[ruleNumber]() {
-										goto l170
+									goto l57
+								l65:
+									position, tokenIndex = position57, tokenIndex57
+									{
+										position77, tokenIndex77 := position, tokenIndex
+										if !_rules[ruleAT]() {
+											goto l77
+										}
+										goto l78
+									l77:
+										position, tokenIndex = position77, tokenIndex77
+									}
+								l78:
+									if !_rules[ruleTime]() {
+										goto l76
 									}
 									{
-										add(ruleAction42, position)
+										add(ruleAction13, position)
 									}
+								l80:
 									{
-										position191, tokenIndex191 := position, tokenIndex
-										if !_rules[ruleMinutes]() {
-											goto l191
+										position81, tokenIndex81 := position, tokenIndex
+										if !_rules[ruleAnd]() {
+											goto l81
+										}
+										if !_rules[ruleTime]() {
+											goto l81
 										}
 										{
-											position193, tokenIndex193 := position, tokenIndex
-											if !_rules[ruleSeconds]() {
-												goto l193
-											}
-											goto l194
-										l193:
-											position, tokenIndex = position193, tokenIndex193
+											add(ruleAction14, position)
 										}
-									l194:
-										goto l192
-									l191:
-										position, tokenIndex = position191, tokenIndex191
-									}
-								l192:
-									add(ruleClock24Hour, position189)
-								}
-							}
-						l172:
-							add(ruleTime, position171)
-						}
-						goto l5
-					l170:
-						position, tokenIndex = position5, tokenIndex5
-						{
-							position195 := position
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l0
-							}
-							position++
-						l196:
-							{
-								position197, tokenIndex197 := position, tokenIndex
-								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l197
-								}
-								position++
-								goto l196
-							l197:
-								position, tokenIndex = position197, tokenIndex197
-							}
-							if !_rules[rule_]() {
-								goto l0
-							}
-							add(ruleWord, position195)
-						}
-					}
-				l5:
-					add(ruleExpr, position4)
-				}
-			l2:
-				{
-					position3, tokenIndex3 := position, tokenIndex
-					{
-						position198 := position
-						{
-							position199, tokenIndex199 := position, tokenIndex
-							{
-								position201 := position
-								if buffer[position] != rune('n') {
-									goto l200
-								}
-								position++
-								if buffer[position] != rune('o') {
-									goto l200
-								}
-								position++
-								if buffer[position] != rune('w') {
-									goto l200
-								}
-								position++
-								if !_rules[rule_]() {
-									goto l200
-								}
-								add(ruleNOW, position201)
-							}
-							goto l199
-						l200:
-							position, tokenIndex = position199, tokenIndex199
-							{
-								position203 := position
-								{
-									position204, tokenIndex204 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l205
+										goto l80
+									l81:
+										position, tokenIndex = position81, tokenIndex81
 									}
-									if !_rules[ruleMINUTES]() {
-										goto l205
+									goto l57
+								l76:
+									position, tokenIndex = position57, tokenIndex57
+									{
+										position84, tokenIndex84 := position, tokenIndex
+										if !_rules[ruleFROM]() {
+											goto l85
+										}
+										goto l84
+									l85:
+										position, tokenIndex = position84, tokenIndex84
+										if !_rules[ruleBETWEEN]() {
+											goto l83
+										}
 									}
-									if !_rules[ruleAGO]() {
-										goto l205
+								l84:
+									if !_rules[ruleTime]() {
+										goto l83
 									}
 									{
-										add(ruleAction0, position)
+										add(ruleAction15, position)
 									}
-									goto l204
-								l205:
-									position, tokenIndex = position204, tokenIndex204
 									{
-										position208, tokenIndex208 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l209
-										}
-										if !_rules[ruleMINUTES]() {
-											goto l209
-										}
-										if !_rules[ruleFROM_NOW]() {
-											goto l209
+										position87, tokenIndex87 := position, tokenIndex
+										if !_rules[ruleTO]() {
+											goto l88
 										}
-										goto l208
-									l209:
-										position, tokenIndex = position208, tokenIndex208
-										if !_rules[ruleIn]() {
-											goto l207
+										goto l87
+									l88:
+										position, tokenIndex = position87, tokenIndex87
+										if !_rules[ruleAND]() {
+											goto l83
 										}
-										{
-											position210, tokenIndex210 := position, tokenIndex
-											if !_rules[ruleNumber]() {
-												goto l210
-											}
-											goto l211
-										l210:
-											position, tokenIndex = position210, tokenIndex210
-										}
-									l211:
-										if !_rules[ruleMINUTES]() {
-											goto l207
-										}
-										{
-											position212, tokenIndex212 := position, tokenIndex
-											if !_rules[ruleFROM_NOW]() {
-												goto l212
-											}
-											goto l213
-										l212:
-											position, tokenIndex = position212, tokenIndex212
-										}
-									l213:
-									}
-								l208:
-									{
-										add(ruleAction1, position)
 									}
-									goto l204
-								l207:
-									position, tokenIndex = position204, tokenIndex204
-									if !_rules[ruleLast]() {
-										goto l215
+								l87:
+									if !_rules[ruleTime]() {
+										goto l83
 									}
 									{
-										position216, tokenIndex216 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l216
-										}
-										goto l217
-									l216:
-										position, tokenIndex = position216, tokenIndex216
-									}
-								l217:
-									if !_rules[ruleMINUTES]() {
-										goto l215
+										add(ruleAction16, position)
 									}
-									{
-										add(ruleAction2, position)
+									goto l57
+								l83:
+									position, tokenIndex = position57, tokenIndex57
+									if !_rules[ruleON]() {
+										goto l90
 									}
-									goto l204
-								l215:
-									position, tokenIndex = position204, tokenIndex204
-									if !_rules[ruleNext]() {
-										goto l219
+									if !_rules[ruleBUSINESS_DAYS]() {
+										goto l90
 									}
 									{
-										position220, tokenIndex220 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l220
-										}
-										goto l221
-									l220:
-										position, tokenIndex = position220, tokenIndex220
+										add(ruleAction17, position)
+									}
+									goto l57
+								l90:
+									position, tokenIndex = position57, tokenIndex57
+									if !_rules[ruleON]() {
+										goto l92
 									}
-								l221:
-									if !_rules[ruleMINUTES]() {
-										goto l219
+									if !_rules[ruleWEEKEND]() {
+										goto l92
 									}
 									{
-										add(ruleAction3, position)
+										add(ruleAction18, position)
 									}
-									goto l204
-								l219:
-									position, tokenIndex = position204, tokenIndex204
-									if !_rules[ruleNumber]() {
-										goto l202
+									goto l57
+								l92:
+									position, tokenIndex = position57, tokenIndex57
+									if !_rules[ruleON]() {
+										goto l94
 									}
-									if !_rules[ruleMINUTES]() {
-										goto l202
+									if !_rules[ruleWeekdays]() {
+										goto l94
 									}
+									goto l57
+								l94:
+									position, tokenIndex = position57, tokenIndex57
 									{
-										add(ruleAction4, position)
+										switch buffer[position] {
+										case 'i':
+											if buffer[position] != rune('i') {
+												goto l55
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l55
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l55
+											}
+											{
+												position96 := position
+												if !_rules[ruleMonth]() {
+													goto l55
+												}
+												{
+													add(ruleAction21, position)
+												}
+											l98:
+												{
+													position99, tokenIndex99 := position, tokenIndex
+													if !_rules[ruleAnd]() {
+														goto l99
+													}
+													if !_rules[ruleMonth]() {
+														goto l99
+													}
+													{
+														add(ruleAction22, position)
+													}
+													goto l98
+												l99:
+													position, tokenIndex = position99, tokenIndex99
+												}
+												add(ruleMonths, position96)
+											}
+										case 'o':
+											if !_rules[ruleON]() {
+												goto l55
+											}
+											{
+												position101, tokenIndex101 := position, tokenIndex
+												if !_rules[ruleTHE]() {
+													goto l101
+												}
+												goto l102
+											l101:
+												position, tokenIndex = position101, tokenIndex101
+											}
+										l102:
+											if !_rules[ruleMonthDays]() {
+												goto l55
+											}
+										default:
+											{
+												position103 := position
+												{
+													position104, tokenIndex104 := position, tokenIndex
+													if buffer[position] != rune('e') {
+														goto l105
+													}
+													position++
+													if buffer[position] != rune('x') {
+														goto l105
+													}
+													position++
+													if buffer[position] != rune('c') {
+														goto l105
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l105
+													}
+													position++
+													if buffer[position] != rune('p') {
+														goto l105
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l105
+													}
+													position++
+													goto l104
+												l105:
+													position, tokenIndex = position104, tokenIndex104
+													if buffer[position] != rune('e') {
+														goto l106
+													}
+													position++
+													if buffer[position] != rune('x') {
+														goto l106
+													}
+													position++
+													if buffer[position] != rune('c') {
+														goto l106
+													}
+													position++
+													if buffer[position] != rune('l') {
+														goto l106
+													}
+													position++
+													if buffer[position] != rune('u') {
+														goto l106
+													}
+													position++
+													if buffer[position] != rune('d') {
+														goto l106
+													}
+													position++
+													if buffer[position] != rune('i') {
+														goto l106
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l106
+													}
+													position++
+													if buffer[position] != rune('g') {
+														goto l106
+													}
+													position++
+													goto l104
+												l106:
+													position, tokenIndex = position104, tokenIndex104
+													if buffer[position] != rune('b') {
+														goto l55
+													}
+													position++
+													if buffer[position] != rune('u') {
+														goto l55
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l55
+													}
+													position++
+													if buffer[position] != rune(' ') {
+														goto l55
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l55
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l55
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l55
+													}
+													position++
+												}
+											l104:
+												if !_rules[rule_]() {
+													goto l55
+												}
+												add(ruleEXCEPT, position103)
+											}
+											if !_rules[ruleExclusion]() {
+												goto l55
+											}
+										l107:
+											{
+												position108, tokenIndex108 := position, tokenIndex
+												if !_rules[ruleAnd]() {
+													goto l108
+												}
+												if !_rules[ruleExclusion]() {
+													goto l108
+												}
+												goto l107
+											l108:
+												position, tokenIndex = position108, tokenIndex108
+											}
+										}
 									}
+
 								}
-							l204:
-								add(ruleRelativeMinutes, position203)
+							l57:
+								add(ruleLimit, position56)
+							}
+							goto l54
+						l55:
+							position, tokenIndex = position55, tokenIndex55
+						}
+						{
+							position109, tokenIndex109 := position, tokenIndex
+							if !_rules[ruleUNTIL]() {
+								goto l109
 							}
-							goto l199
-						l202:
-							position, tokenIndex = position199, tokenIndex199
 							{
-								position225 := position
+								add(ruleAction10, position)
+							}
+							{
+								position112, tokenIndex112 := position, tokenIndex
 								{
-									position226, tokenIndex226 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l227
-									}
-									if !_rules[ruleHOURS]() {
-										goto l227
-									}
-									if !_rules[ruleAGO]() {
-										goto l227
-									}
-									{
-										add(ruleAction5, position)
+									position114 := position
+									if !_rules[ruleMonth]() {
+										goto l113
 									}
-									goto l226
-								l227:
-									position, tokenIndex = position226, tokenIndex226
 									{
-										position230, tokenIndex230 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l231
-										}
-										if !_rules[ruleHOURS]() {
-											goto l231
-										}
-										if !_rules[ruleFROM_NOW]() {
-											goto l231
-										}
-										goto l230
-									l231:
-										position, tokenIndex = position230, tokenIndex230
-										if !_rules[ruleIn]() {
-											goto l229
+										position115, tokenIndex115 := position, tokenIndex
+										if !_rules[ruleYear]() {
+											goto l115
 										}
 										{
-											position232, tokenIndex232 := position, tokenIndex
-											if !_rules[ruleNumber]() {
-												goto l232
+											position117, tokenIndex117 := position, tokenIndex
+											if c := buffer[position]; c < rune('0') || c > rune('9') {
+												goto l117
 											}
-											goto l233
-										l232:
-											position, tokenIndex = position232, tokenIndex232
-										}
-									l233:
-										if !_rules[ruleHOURS]() {
-											goto l229
+											position++
+											goto l115
+										l117:
+											position, tokenIndex = position117, tokenIndex117
 										}
-										{
-											position234, tokenIndex234 := position, tokenIndex
-											if !_rules[ruleFROM_NOW]() {
-												goto l234
-											}
-											goto l235
-										l234:
-											position, tokenIndex = position234, tokenIndex234
+										if !_rules[rule_]() {
+											goto l115
 										}
-									l235:
-									}
-								l230:
-									{
-										add(ruleAction6, position)
-									}
-									goto l226
-								l229:
-									position, tokenIndex = position226, tokenIndex226
-									if !_rules[ruleLast]() {
-										goto l237
+										goto l116
+									l115:
+										position, tokenIndex = position115, tokenIndex115
 									}
+								l116:
 									{
-										position238, tokenIndex238 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l238
+										position118, tokenIndex118 := position, tokenIndex
+										if !_rules[ruleEOF]() {
+											goto l113
 										}
-										goto l239
-									l238:
-										position, tokenIndex = position238, tokenIndex238
-									}
-								l239:
-									if !_rules[ruleHOURS]() {
-										goto l237
+										position, tokenIndex = position118, tokenIndex118
 									}
 									{
-										add(ruleAction7, position)
+										add(ruleAction11, position)
 									}
-									goto l226
-								l237:
-									position, tokenIndex = position226, tokenIndex226
-									if !_rules[ruleNext]() {
-										goto l241
+									add(ruleUntilMonth, position114)
+								}
+								goto l112
+							l113:
+								position, tokenIndex = position112, tokenIndex112
+								if !_rules[ruleExpr]() {
+									goto l109
+								}
+							l120:
+								{
+									position121, tokenIndex121 := position, tokenIndex
+									if !_rules[ruleExpr]() {
+										goto l121
 									}
-									{
-										position242, tokenIndex242 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l242
-										}
-										goto l243
-									l242:
-										position, tokenIndex = position242, tokenIndex242
+									goto l120
+								l121:
+									position, tokenIndex = position121, tokenIndex121
+								}
+							}
+						l112:
+							goto l110
+						l109:
+							position, tokenIndex = position109, tokenIndex109
+						}
+					l110:
+						add(ruleRecurrence, position7)
+					}
+					if !_rules[ruleEOF]() {
+						goto l6
+					}
+					goto l2
+				l6:
+					position, tokenIndex = position2, tokenIndex2
+					if !(p.event) {
+						goto l0
+					}
+					if !_rules[rule_]() {
+						goto l0
+					}
+					{
+						position124, tokenIndex124 := position, tokenIndex
+						{
+							position126 := position
+							if !_rules[ruleFOR]() {
+								goto l125
+							}
+							{
+								add(ruleAction0, position)
+							}
+							if !_rules[ruleEventLength]() {
+								goto l125
+							}
+						l128:
+							{
+								position129, tokenIndex129 := position, tokenIndex
+								{
+									position130, tokenIndex130 := position, tokenIndex
+									if !_rules[ruleAND]() {
+										goto l130
 									}
-								l243:
-									if !_rules[ruleHOURS]() {
-										goto l241
+									goto l131
+								l130:
+									position, tokenIndex = position130, tokenIndex130
+								}
+							l131:
+								if !_rules[ruleEventLength]() {
+									goto l129
+								}
+								goto l128
+							l129:
+								position, tokenIndex = position129, tokenIndex129
+							}
+							add(ruleEventDuration, position126)
+						}
+						goto l124
+					l125:
+						position, tokenIndex = position124, tokenIndex124
+						{
+							position133 := position
+							{
+								position134, tokenIndex134 := position, tokenIndex
+								{
+									position136, tokenIndex136 := position, tokenIndex
+									if !_rules[ruleFROM]() {
+										goto l136
 									}
-									{
-										add(ruleAction8, position)
+									goto l137
+								l136:
+									position, tokenIndex = position136, tokenIndex136
+								}
+							l137:
+								if !_rules[ruleEventStart]() {
+									goto l135
+								}
+								{
+									position138, tokenIndex138 := position, tokenIndex
+									if buffer[position] != rune('-') {
+										goto l139
 									}
-									goto l226
-								l241:
-									position, tokenIndex = position226, tokenIndex226
-									if !_rules[ruleNumber]() {
-										goto l224
+									position++
+									if !_rules[rule_]() {
+										goto l139
 									}
-									if !_rules[ruleHOURS]() {
-										goto l224
+									goto l138
+								l139:
+									position, tokenIndex = position138, tokenIndex138
+									if !_rules[ruleTO]() {
+										goto l140
 									}
-									{
-										add(ruleAction9, position)
+									goto l138
+								l140:
+									position, tokenIndex = position138, tokenIndex138
+									if !_rules[ruleUNTIL]() {
+										goto l135
 									}
 								}
-							l226:
-								add(ruleRelativeHours, position225)
+							l138:
+								if !_rules[ruleEventEnd]() {
+									goto l135
+								}
+								goto l134
+							l135:
+								position, tokenIndex = position134, tokenIndex134
+								if !_rules[ruleBETWEEN]() {
+									goto l132
+								}
+								if !_rules[ruleEventStart]() {
+									goto l132
+								}
+								if !_rules[ruleAND]() {
+									goto l132
+								}
+								if !_rules[ruleEventEnd]() {
+									goto l132
+								}
 							}
-							goto l199
-						l224:
-							position, tokenIndex = position199, tokenIndex199
+						l134:
+							add(ruleEventTimes, position133)
+						}
+						goto l124
+					l132:
+						position, tokenIndex = position124, tokenIndex124
+						{
+							position142 := position
 							{
-								position247 := position
+								position143, tokenIndex143 := position, tokenIndex
+								if !_rules[ruleAT]() {
+									goto l144
+								}
+								if !_rules[ruleTime]() {
+									goto l144
+								}
+								goto l143
+							l144:
+								position, tokenIndex = position143, tokenIndex143
 								{
-									position248, tokenIndex248 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l249
-									}
-									if !_rules[ruleDAYS]() {
-										goto l249
-									}
-									if !_rules[ruleAGO]() {
-										goto l249
-									}
-									{
-										add(ruleAction10, position)
-									}
-									goto l248
-								l249:
-									position, tokenIndex = position248, tokenIndex248
+									position145, tokenIndex145 := position, tokenIndex
 									{
-										position252, tokenIndex252 := position, tokenIndex
+										position146 := position
 										if !_rules[ruleNumber]() {
-											goto l253
-										}
-										if !_rules[ruleDAYS]() {
-											goto l253
-										}
-										if !_rules[ruleFROM_NOW]() {
-											goto l253
-										}
-										goto l252
-									l253:
-										position, tokenIndex = position252, tokenIndex252
-										if !_rules[ruleIn]() {
-											goto l251
+											goto l145
 										}
 										{
-											position254, tokenIndex254 := position, tokenIndex
-											if !_rules[ruleNumber]() {
-												goto l254
+											position147, tokenIndex147 := position, tokenIndex
+											if buffer[position] != rune(':') {
+												goto l147
 											}
-											goto l255
-										l254:
-											position, tokenIndex = position254, tokenIndex254
+											position++
+											goto l145
+										l147:
+											position, tokenIndex = position147, tokenIndex147
 										}
-									l255:
-										if !_rules[ruleDAYS]() {
-											goto l251
+										{
+											position148, tokenIndex148 := position, tokenIndex
+											if !_rules[ruleAM]() {
+												goto l148
+											}
+											goto l145
+										l148:
+											position, tokenIndex = position148, tokenIndex148
 										}
 										{
-											position256, tokenIndex256 := position, tokenIndex
-											if !_rules[ruleFROM_NOW]() {
-												goto l256
+											position149, tokenIndex149 := position, tokenIndex
+											if !_rules[rulePM]() {
+												goto l149
 											}
-											goto l257
-										l256:
-											position, tokenIndex = position256, tokenIndex256
+											goto l145
+										l149:
+											position, tokenIndex = position149, tokenIndex149
 										}
-									l257:
-									}
-								l252:
-									{
-										add(ruleAction11, position)
+										add(ruleBareHour, position146)
 									}
-									goto l248
-								l251:
-									position, tokenIndex = position248, tokenIndex248
-									if !_rules[ruleLast]() {
-										goto l259
+									goto l141
+								l145:
+									position, tokenIndex = position145, tokenIndex145
+								}
+								if !_rules[ruleTime]() {
+									goto l141
+								}
+							}
+						l143:
+							add(ruleEventTime, position142)
+						}
+						goto l124
+					l141:
+						position, tokenIndex = position124, tokenIndex124
+						{
+							position151 := position
+							{
+								position152 := position
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l150
+								}
+								position++
+							l153:
+								{
+									position154, tokenIndex154 := position, tokenIndex
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l154
 									}
+									position++
+									goto l153
+								l154:
+									position, tokenIndex = position154, tokenIndex154
+								}
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l150
+								}
+								position++
+							l155:
+								{
+									position156, tokenIndex156 := position, tokenIndex
 									{
-										position260, tokenIndex260 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l260
+										position157, tokenIndex157 := position, tokenIndex
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l158
 										}
-										goto l261
-									l260:
-										position, tokenIndex = position260, tokenIndex260
-									}
-								l261:
-									if !_rules[ruleDAYS]() {
-										goto l259
+										position++
+										goto l157
+									l158:
+										position, tokenIndex = position157, tokenIndex157
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l156
+										}
+										position++
 									}
-									{
-										add(ruleAction12, position)
+								l157:
+									goto l155
+								l156:
+									position, tokenIndex = position156, tokenIndex156
+								}
+								add(rulePegText, position152)
+							}
+							if !_rules[rule_]() {
+								goto l150
+							}
+							{
+								add(ruleAction8, position)
+							}
+							add(ruleEventWord, position151)
+						}
+						goto l124
+					l150:
+						position, tokenIndex = position124, tokenIndex124
+						if !_rules[ruleMoment]() {
+							goto l160
+						}
+						goto l124
+					l160:
+						position, tokenIndex = position124, tokenIndex124
+						if !_rules[ruleWord]() {
+							goto l161
+						}
+						goto l124
+					l161:
+						position, tokenIndex = position124, tokenIndex124
+						{
+							position162 := position
+							{
+								position163 := position
+								{
+									position166, tokenIndex166 := position, tokenIndex
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l166
 									}
-									goto l248
-								l259:
-									position, tokenIndex = position248, tokenIndex248
-									if !_rules[ruleNext]() {
-										goto l263
+									position++
+									goto l0
+								l166:
+									position, tokenIndex = position166, tokenIndex166
+								}
+								{
+									position167, tokenIndex167 := position, tokenIndex
+									if !_rules[ruleWhitespace]() {
+										goto l167
 									}
+									goto l0
+								l167:
+									position, tokenIndex = position167, tokenIndex167
+								}
+								if !matchDot() {
+									goto l0
+								}
+							l164:
+								{
+									position165, tokenIndex165 := position, tokenIndex
 									{
-										position264, tokenIndex264 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l264
+										position168, tokenIndex168 := position, tokenIndex
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l168
 										}
-										goto l265
-									l264:
-										position, tokenIndex = position264, tokenIndex264
-									}
-								l265:
-									if !_rules[ruleDAYS]() {
-										goto l263
+										position++
+										goto l165
+									l168:
+										position, tokenIndex = position168, tokenIndex168
 									}
 									{
-										add(ruleAction13, position)
-									}
-									goto l248
-								l263:
-									position, tokenIndex = position248, tokenIndex248
-									if !_rules[ruleNumber]() {
-										goto l246
-									}
-									if !_rules[ruleDAYS]() {
-										goto l246
+										position169, tokenIndex169 := position, tokenIndex
+										if !_rules[ruleWhitespace]() {
+											goto l169
+										}
+										goto l165
+									l169:
+										position, tokenIndex = position169, tokenIndex169
 									}
-									{
-										add(ruleAction14, position)
+									if !matchDot() {
+										goto l165
 									}
+									goto l164
+								l165:
+									position, tokenIndex = position165, tokenIndex165
 								}
-							l248:
-								add(ruleRelativeDays, position247)
+								add(rulePegText, position163)
 							}
-							goto l199
-						l246:
-							position, tokenIndex = position199, tokenIndex199
-							{
-								position269 := position
+							if !_rules[rule_]() {
+								goto l0
+							}
+							{
+								add(ruleAction9, position)
+							}
+							add(ruleText, position162)
+						}
+					}
+				l124:
+				l122:
+					{
+						position123, tokenIndex123 := position, tokenIndex
+						{
+							position171, tokenIndex171 := position, tokenIndex
+							{
+								position173 := position
+								if !_rules[ruleFOR]() {
+									goto l172
+								}
 								{
-									position270, tokenIndex270 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l271
-									}
-									if !_rules[ruleWEEKS]() {
-										goto l271
-									}
-									if !_rules[ruleAGO]() {
-										goto l271
-									}
-									{
-										add(ruleAction15, position)
-									}
-									goto l270
-								l271:
-									position, tokenIndex = position270, tokenIndex270
+									add(ruleAction0, position)
+								}
+								if !_rules[ruleEventLength]() {
+									goto l172
+								}
+							l175:
+								{
+									position176, tokenIndex176 := position, tokenIndex
 									{
-										position274, tokenIndex274 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l275
-										}
-										if !_rules[ruleWEEKS]() {
-											goto l275
-										}
-										if !_rules[ruleFROM_NOW]() {
-											goto l275
-										}
-										goto l274
-									l275:
-										position, tokenIndex = position274, tokenIndex274
-										if !_rules[ruleIn]() {
-											goto l273
-										}
-										{
-											position276, tokenIndex276 := position, tokenIndex
-											if !_rules[ruleNumber]() {
-												goto l276
-											}
-											goto l277
-										l276:
-											position, tokenIndex = position276, tokenIndex276
-										}
-									l277:
-										if !_rules[ruleWEEKS]() {
-											goto l273
-										}
-										{
-											position278, tokenIndex278 := position, tokenIndex
-											if !_rules[ruleFROM_NOW]() {
-												goto l278
-											}
-											goto l279
-										l278:
-											position, tokenIndex = position278, tokenIndex278
+										position177, tokenIndex177 := position, tokenIndex
+										if !_rules[ruleAND]() {
+											goto l177
 										}
-									l279:
-									}
-								l274:
-									{
-										add(ruleAction16, position)
+										goto l178
+									l177:
+										position, tokenIndex = position177, tokenIndex177
 									}
-									goto l270
-								l273:
-									position, tokenIndex = position270, tokenIndex270
-									if !_rules[ruleLast]() {
-										goto l281
+								l178:
+									if !_rules[ruleEventLength]() {
+										goto l176
 									}
+									goto l175
+								l176:
+									position, tokenIndex = position176, tokenIndex176
+								}
+								add(ruleEventDuration, position173)
+							}
+							goto l171
+						l172:
+							position, tokenIndex = position171, tokenIndex171
+							{
+								position180 := position
+								{
+									position181, tokenIndex181 := position, tokenIndex
 									{
-										position282, tokenIndex282 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l282
+										position183, tokenIndex183 := position, tokenIndex
+										if !_rules[ruleFROM]() {
+											goto l183
 										}
-										goto l283
-									l282:
-										position, tokenIndex = position282, tokenIndex282
-									}
-								l283:
-									if !_rules[ruleWEEKS]() {
-										goto l281
+										goto l184
+									l183:
+										position, tokenIndex = position183, tokenIndex183
 									}
-									{
-										add(ruleAction17, position)
-									}
-									goto l270
-								l281:
-									position, tokenIndex = position270, tokenIndex270
-									if !_rules[ruleNext]() {
-										goto l285
+								l184:
+									if !_rules[ruleEventStart]() {
+										goto l182
 									}
 									{
-										position286, tokenIndex286 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l286
+										position185, tokenIndex185 := position, tokenIndex
+										if buffer[position] != rune('-') {
+											goto l186
+										}
+										position++
+										if !_rules[rule_]() {
+											goto l186
+										}
+										goto l185
+									l186:
+										position, tokenIndex = position185, tokenIndex185
+										if !_rules[ruleTO]() {
+											goto l187
+										}
+										goto l185
+									l187:
+										position, tokenIndex = position185, tokenIndex185
+										if !_rules[ruleUNTIL]() {
+											goto l182
 										}
-										goto l287
-									l286:
-										position, tokenIndex = position286, tokenIndex286
 									}
-								l287:
-									if !_rules[ruleWEEKS]() {
-										goto l285
+								l185:
+									if !_rules[ruleEventEnd]() {
+										goto l182
 									}
-									{
-										add(ruleAction18, position)
+									goto l181
+								l182:
+									position, tokenIndex = position181, tokenIndex181
+									if !_rules[ruleBETWEEN]() {
+										goto l179
 									}
-									goto l270
-								l285:
-									position, tokenIndex = position270, tokenIndex270
-									if !_rules[ruleNumber]() {
-										goto l268
+									if !_rules[ruleEventStart]() {
+										goto l179
 									}
-									if !_rules[ruleWEEKS]() {
-										goto l268
+									if !_rules[ruleAND]() {
+										goto l179
 									}
-									{
-										add(ruleAction19, position)
+									if !_rules[ruleEventEnd]() {
+										goto l179
 									}
 								}
-							l270:
-								add(ruleRelativeWeeks, position269)
+							l181:
+								add(ruleEventTimes, position180)
 							}
-							goto l199
-						l268:
-							position, tokenIndex = position199, tokenIndex199
+							goto l171
+						l179:
+							position, tokenIndex = position171, tokenIndex171
 							{
-								position291 := position
+								position189 := position
 								{
-									position292, tokenIndex292 := position, tokenIndex
-									{
-										position294 := position
-										if buffer[position] != rune('t') {
-											goto l293
-										}
-										position++
-										if buffer[position] != rune('o') {
-											goto l293
-										}
-										position++
-										if buffer[position] != rune('d') {
-											goto l293
-										}
-										position++
-										if buffer[position] != rune('a') {
-											goto l293
-										}
-										position++
-										if buffer[position] != rune('y') {
-											goto l293
-										}
-										position++
-										if !_rules[rule_]() {
-											goto l293
-										}
-										add(ruleTODAY, position294)
-									}
-									{
-										add(ruleAction33, position)
-									}
-									goto l292
-								l293:
-									position, tokenIndex = position292, tokenIndex292
-									{
-										position297 := position
-										if buffer[position] != rune('t') {
-											goto l296
-										}
-										position++
-										if buffer[position] != rune('o') {
-											goto l296
-										}
-										position++
-										if buffer[position] != rune('m') {
-											goto l296
-										}
-										position++
-										if buffer[position] != rune('o') {
-											goto l296
-										}
-										position++
-										if buffer[position] != rune('r') {
-											goto l296
-										}
-										position++
-										if buffer[position] != rune('r') {
-											goto l296
-										}
-										position++
-										if buffer[position] != rune('o') {
-											goto l296
-										}
-										position++
-										if buffer[position] != rune('w') {
-											goto l296
-										}
-										position++
-										if !_rules[rule_]() {
-											goto l296
-										}
-										add(ruleTOMORROW, position297)
+									position190, tokenIndex190 := position, tokenIndex
+									if !_rules[ruleAT]() {
+										goto l191
 									}
-									{
-										add(ruleAction35, position)
+									if !_rules[ruleTime]() {
+										goto l191
 									}
-									goto l292
-								l296:
-									position, tokenIndex = position292, tokenIndex292
+									goto l190
+								l191:
+									position, tokenIndex = position190, tokenIndex190
 									{
-										switch buffer[position] {
-										case 'n':
-											if !_rules[ruleNEXT]() {
-												goto l290
-											}
-											if !_rules[ruleWeekday]() {
-												goto l290
-											}
-											{
-												add(ruleAction37, position)
+										position192, tokenIndex192 := position, tokenIndex
+										{
+											position193 := position
+											if !_rules[ruleNumber]() {
+												goto l192
 											}
-											break
-										case 'y':
 											{
-												position301 := position
-												if buffer[position] != rune('y') {
-													goto l290
-												}
-												position++
-												if buffer[position] != rune('e') {
-													goto l290
-												}
-												position++
-												if buffer[position] != rune('s') {
-													goto l290
-												}
-												position++
-												if buffer[position] != rune('t') {
-													goto l290
-												}
-												position++
-												if buffer[position] != rune('e') {
-													goto l290
-												}
-												position++
-												if buffer[position] != rune('r') {
-													goto l290
-												}
-												position++
-												if buffer[position] != rune('d') {
-													goto l290
-												}
-												position++
-												if buffer[position] != rune('a') {
-													goto l290
-												}
-												position++
-												if buffer[position] != rune('y') {
-													goto l290
+												position194, tokenIndex194 := position, tokenIndex
+												if buffer[position] != rune(':') {
+													goto l194
 												}
 												position++
-												if !_rules[rule_]() {
-													goto l290
-												}
-												add(ruleYESTERDAY, position301)
-											}
-											{
-												add(ruleAction34, position)
-											}
-											break
-										case 'l', 'p':
-											if !_rules[ruleLAST]() {
-												goto l290
-											}
-											if !_rules[ruleWeekday]() {
-												goto l290
+												goto l192
+											l194:
+												position, tokenIndex = position194, tokenIndex194
 											}
 											{
-												add(ruleAction36, position)
-											}
-											break
-										default:
-											if !_rules[ruleWeekday]() {
-												goto l290
+												position195, tokenIndex195 := position, tokenIndex
+												if !_rules[ruleAM]() {
+													goto l195
+												}
+												goto l192
+											l195:
+												position, tokenIndex = position195, tokenIndex195
 											}
 											{
-												add(ruleAction38, position)
+												position196, tokenIndex196 := position, tokenIndex
+												if !_rules[rulePM]() {
+													goto l196
+												}
+												goto l192
+											l196:
+												position, tokenIndex = position196, tokenIndex196
 											}
-											break
+											add(ruleBareHour, position193)
 										}
+										goto l188
+									l192:
+										position, tokenIndex = position192, tokenIndex192
+									}
+									if !_rules[ruleTime]() {
+										goto l188
 									}
-
 								}
-							l292:
-								add(ruleRelativeWeekdays, position291)
+							l190:
+								add(ruleEventTime, position189)
 							}
-							goto l199
-						l290:
-							position, tokenIndex = position199, tokenIndex199
+							goto l171
+						l188:
+							position, tokenIndex = position171, tokenIndex171
 							{
-								position306 := position
+								position198 := position
 								{
-									position307, tokenIndex307 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l308
-									}
-									if !_rules[ruleMONTHS]() {
-										goto l308
-									}
-									if !_rules[ruleAGO]() {
-										goto l308
+									position199 := position
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l197
 									}
+									position++
+								l200:
 									{
-										add(ruleAction20, position)
+										position201, tokenIndex201 := position, tokenIndex
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l201
+										}
+										position++
+										goto l200
+									l201:
+										position, tokenIndex = position201, tokenIndex201
+									}
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l197
 									}
-									goto l307
-								l308:
-									position, tokenIndex = position307, tokenIndex307
+									position++
+								l202:
 									{
-										position311, tokenIndex311 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l312
-										}
-										if !_rules[ruleMONTHS]() {
-											goto l312
-										}
-										if !_rules[ruleFROM_NOW]() {
-											goto l312
-										}
-										goto l311
-									l312:
-										position, tokenIndex = position311, tokenIndex311
-										if !_rules[ruleIn]() {
-											goto l310
-										}
+										position203, tokenIndex203 := position, tokenIndex
 										{
-											position313, tokenIndex313 := position, tokenIndex
-											if !_rules[ruleNumber]() {
-												goto l313
+											position204, tokenIndex204 := position, tokenIndex
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												goto l205
 											}
-											goto l314
-										l313:
-											position, tokenIndex = position313, tokenIndex313
-										}
-									l314:
-										if !_rules[ruleMONTHS]() {
-											goto l310
-										}
-										{
-											position315, tokenIndex315 := position, tokenIndex
-											if !_rules[ruleFROM_NOW]() {
-												goto l315
+											position++
+											goto l204
+										l205:
+											position, tokenIndex = position204, tokenIndex204
+											if c := buffer[position]; c < rune('0') || c > rune('9') {
+												goto l203
 											}
-											goto l316
-										l315:
-											position, tokenIndex = position315, tokenIndex315
-										}
-									l316:
-									}
-								l311:
-									{
-										add(ruleAction21, position)
-									}
-									goto l307
-								l310:
-									position, tokenIndex = position307, tokenIndex307
-									if !_rules[ruleLast]() {
-										goto l318
-									}
-									{
-										position319, tokenIndex319 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l319
-										}
-										goto l320
-									l319:
-										position, tokenIndex = position319, tokenIndex319
-									}
-								l320:
-									if !_rules[ruleMONTHS]() {
-										goto l318
-									}
-									{
-										add(ruleAction22, position)
-									}
-									goto l307
-								l318:
-									position, tokenIndex = position307, tokenIndex307
-									if !_rules[ruleNext]() {
-										goto l322
-									}
-									{
-										position323, tokenIndex323 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l323
+											position++
 										}
-										goto l324
-									l323:
-										position, tokenIndex = position323, tokenIndex323
-									}
-								l324:
-									if !_rules[ruleMONTHS]() {
-										goto l322
-									}
-									{
-										add(ruleAction23, position)
-									}
-									goto l307
-								l322:
-									position, tokenIndex = position307, tokenIndex307
-									if !_rules[ruleLAST]() {
-										goto l326
-									}
-									if !_rules[ruleMonth]() {
-										goto l326
-									}
-									{
-										add(ruleAction24, position)
-									}
-									goto l307
-								l326:
-									position, tokenIndex = position307, tokenIndex307
-									if !_rules[ruleNEXT]() {
-										goto l328
-									}
-									if !_rules[ruleMonth]() {
-										goto l328
-									}
-									{
-										add(ruleAction25, position)
-									}
-									goto l307
-								l328:
-									position, tokenIndex = position307, tokenIndex307
-									if !_rules[ruleMonth]() {
-										goto l305
-									}
-									{
-										add(ruleAction26, position)
+									l204:
+										goto l202
+									l203:
+										position, tokenIndex = position203, tokenIndex203
 									}
+									add(rulePegText, position199)
+								}
+								if !_rules[rule_]() {
+									goto l197
+								}
+								{
+									add(ruleAction8, position)
 								}
-							l307:
-								add(ruleRelativeMonth, position306)
+								add(ruleEventWord, position198)
 							}
-							goto l199
-						l305:
-							position, tokenIndex = position199, tokenIndex199
+							goto l171
+						l197:
+							position, tokenIndex = position171, tokenIndex171
+							if !_rules[ruleMoment]() {
+								goto l207
+							}
+							goto l171
+						l207:
+							position, tokenIndex = position171, tokenIndex171
+							if !_rules[ruleWord]() {
+								goto l208
+							}
+							goto l171
+						l208:
+							position, tokenIndex = position171, tokenIndex171
 							{
-								position332 := position
+								position209 := position
 								{
-									position333, tokenIndex333 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l334
-									}
-									if !_rules[ruleYEARS]() {
-										goto l334
-									}
-									if !_rules[ruleAGO]() {
-										goto l334
-									}
+									position210 := position
 									{
-										add(ruleAction27, position)
+										position213, tokenIndex213 := position, tokenIndex
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l213
+										}
+										position++
+										goto l123
+									l213:
+										position, tokenIndex = position213, tokenIndex213
 									}
-									goto l333
-								l334:
-									position, tokenIndex = position333, tokenIndex333
 									{
-										position337, tokenIndex337 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l338
-										}
-										if !_rules[ruleYEARS]() {
-											goto l338
-										}
-										if !_rules[ruleFROM_NOW]() {
-											goto l338
-										}
-										goto l337
-									l338:
-										position, tokenIndex = position337, tokenIndex337
-										if !_rules[ruleIn]() {
-											goto l336
+										position214, tokenIndex214 := position, tokenIndex
+										if !_rules[ruleWhitespace]() {
+											goto l214
 										}
+										goto l123
+									l214:
+										position, tokenIndex = position214, tokenIndex214
+									}
+									if !matchDot() {
+										goto l123
+									}
+								l211:
+									{
+										position212, tokenIndex212 := position, tokenIndex
 										{
-											position339, tokenIndex339 := position, tokenIndex
-											if !_rules[ruleNumber]() {
-												goto l339
+											position215, tokenIndex215 := position, tokenIndex
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												goto l215
 											}
-											goto l340
-										l339:
-											position, tokenIndex = position339, tokenIndex339
-										}
-									l340:
-										if !_rules[ruleYEARS]() {
-											goto l336
+											position++
+											goto l212
+										l215:
+											position, tokenIndex = position215, tokenIndex215
 										}
 										{
-											position341, tokenIndex341 := position, tokenIndex
-											if !_rules[ruleFROM_NOW]() {
-												goto l341
+											position216, tokenIndex216 := position, tokenIndex
+											if !_rules[ruleWhitespace]() {
+												goto l216
 											}
-											goto l342
-										l341:
-											position, tokenIndex = position341, tokenIndex341
+											goto l212
+										l216:
+											position, tokenIndex = position216, tokenIndex216
 										}
-									l342:
-									}
-								l337:
-									{
-										add(ruleAction28, position)
-									}
-									goto l333
-								l336:
-									position, tokenIndex = position333, tokenIndex333
-									if !_rules[ruleLast]() {
-										goto l344
-									}
-									{
-										position345, tokenIndex345 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l345
+										if !matchDot() {
+											goto l212
 										}
-										goto l346
-									l345:
-										position, tokenIndex = position345, tokenIndex345
-									}
-								l346:
-									if !_rules[ruleYEARS]() {
-										goto l344
-									}
-									{
-										add(ruleAction29, position)
-									}
-									goto l333
-								l344:
-									position, tokenIndex = position333, tokenIndex333
-									if !_rules[ruleNext]() {
-										goto l348
-									}
-									{
-										position349, tokenIndex349 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l349
-										}
-										goto l350
-									l349:
-										position, tokenIndex = position349, tokenIndex349
-									}
-								l350:
-									if !_rules[ruleYEARS]() {
-										goto l348
-									}
-									{
-										add(ruleAction30, position)
-									}
-									goto l333
-								l348:
-									position, tokenIndex = position333, tokenIndex333
-									if !_rules[ruleLAST]() {
-										goto l352
-									}
-									if !_rules[ruleYEARS]() {
-										goto l352
-									}
-									{
-										add(ruleAction31, position)
-									}
-									goto l333
-								l352:
-									position, tokenIndex = position333, tokenIndex333
-									if !_rules[ruleNEXT]() {
-										goto l331
-									}
-									if !_rules[ruleYEARS]() {
-										goto l331
-									}
-									{
-										add(ruleAction32, position)
-									}
-								}
-							l333:
-								add(ruleRelativeYear, position332)
-							}
-							goto l199
-						l331:
-							position, tokenIndex = position199, tokenIndex199
-							{
-								position356 := position
-								{
-									position357, tokenIndex357 := position, tokenIndex
-									if !_rules[ruleNumber]() {
-										goto l358
-									}
-									{
-										position359 := position
-										{
-											switch buffer[position] {
-											case 't':
-												if buffer[position] != rune('t') {
-													goto l358
-												}
-												position++
-												if buffer[position] != rune('h') {
-													goto l358
-												}
-												position++
-												break
-											case 'r':
-												if buffer[position] != rune('r') {
-													goto l358
-												}
-												position++
-												if buffer[position] != rune('d') {
-													goto l358
-												}
-												position++
-												break
-											case 'n':
-												if buffer[position] != rune('n') {
-													goto l358
-												}
-												position++
-												if buffer[position] != rune('d') {
-													goto l358
-												}
-												position++
-												break
-											default:
-												if buffer[position] != rune('s') {
-													goto l358
-												}
-												position++
-												if buffer[position] != rune('t') {
-													goto l358
-												}
-												position++
-												break
-											}
-										}
-
-										if !_rules[rule_]() {
-											goto l358
-										}
-										add(ruleOrdinal, position359)
-									}
-									goto l357
-								l358:
-									position, tokenIndex = position357, tokenIndex357
-									if !_rules[ruleLast]() {
-										goto l355
-									}
-									{
-										position361, tokenIndex361 := position, tokenIndex
-										if !_rules[ruleNumber]() {
-											goto l361
-										}
-										goto l362
-									l361:
-										position, tokenIndex = position361, tokenIndex361
-									}
-								l362:
-									if !_rules[ruleNumber]() {
-										goto l355
-									}
-								}
-							l357:
-								{
-									add(ruleAction39, position)
-								}
-								add(ruleDate, position356)
-							}
-							goto l199
-						l355:
-							position, tokenIndex = position199, tokenIndex199
-							{
-								position365 := position
-								{
-									position366, tokenIndex366 := position, tokenIndex
-									{
-										position368 := position
-										{
-											position369, tokenIndex369 := position, tokenIndex
-											if !_rules[ruleNumber]() {
-												goto l370
-											}
-											{
-												add(ruleAction40, position)
-											}
-											{
-												position372, tokenIndex372 := position, tokenIndex
-												if !_rules[ruleMinutes]() {
-													goto l372
-												}
-												{
-													position374, tokenIndex374 := position, tokenIndex
-													if !_rules[ruleSeconds]() {
-														goto l374
-													}
-													goto l375
-												l374:
-													position, tokenIndex = position374, tokenIndex374
-												}
-											l375:
-												goto l373
-											l372:
-												position, tokenIndex = position372, tokenIndex372
-											}
-										l373:
-											{
-												position376 := position
-												if buffer[position] != rune('a') {
-													goto l370
-												}
-												position++
-												if buffer[position] != rune('m') {
-													goto l370
-												}
-												position++
-												if !_rules[rule_]() {
-													goto l370
-												}
-												add(ruleAM, position376)
-											}
-											goto l369
-										l370:
-											position, tokenIndex = position369, tokenIndex369
-											if !_rules[ruleNumber]() {
-												goto l367
-											}
-											{
-												add(ruleAction41, position)
-											}
-											{
-												position378, tokenIndex378 := position, tokenIndex
-												if !_rules[ruleMinutes]() {
-													goto l378
-												}
-												{
-													position380, tokenIndex380 := position, tokenIndex
-													if !_rules[ruleSeconds]() {
-														goto l380
-													}
-													goto l381
-												l380:
-													position, tokenIndex = position380, tokenIndex380
-												}
-											l381:
-												goto l379
-											l378:
-												position, tokenIndex = position378, tokenIndex378
-											}
-										l379:
-											{
-												position382 := position
-												if buffer[position] != rune('p') {
-													goto l367
-												}
-												position++
-												if buffer[position] != rune('m') {
-													goto l367
-												}
-												position++
-												if !_rules[rule_]() {
-													goto l367
-												}
-												add(rulePM, position382)
-											}
-										}
-									l369:
-										add(ruleClock12Hour, position368)
-									}
-									goto l366
-								l367:
-									position, tokenIndex = position366, tokenIndex366
-									{
-										position383 := position
-										if !_rules[ruleNumber]() {
-											goto l364
-										}
-										{
-											add(ruleAction42, position)
-										}
-										{
-											position385, tokenIndex385 := position, tokenIndex
-											if !_rules[ruleMinutes]() {
-												goto l385
-											}
-											{
-												position387, tokenIndex387 := position, tokenIndex
-												if !_rules[ruleSeconds]() {
-													goto l387
-												}
-												goto l388
-											l387:
-												position, tokenIndex = position387, tokenIndex387
-											}
-										l388:
-											goto l386
-										l385:
-											position, tokenIndex = position385, tokenIndex385
-										}
-									l386:
-										add(ruleClock24Hour, position383)
+										goto l211
+									l212:
+										position, tokenIndex = position212, tokenIndex212
 									}
+									add(rulePegText, position210)
 								}
-							l366:
-								add(ruleTime, position365)
-							}
-							goto l199
-						l364:
-							position, tokenIndex = position199, tokenIndex199
-							{
-								position389 := position
-								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l3
+								if !_rules[rule_]() {
+									goto l123
 								}
-								position++
-							l390:
 								{
-									position391, tokenIndex391 := position, tokenIndex
-									if c := buffer[position]; c < rune('a') || c > rune('z') {
-										goto l391
-									}
-									position++
-									goto l390
-								l391:
-									position, tokenIndex = position391, tokenIndex391
-								}
-								if !_rules[rule_]() {
-									goto l3
+									add(ruleAction9, position)
 								}
-								add(ruleWord, position389)
+								add(ruleText, position209)
 							}
 						}
-					l199:
-						add(ruleExpr, position198)
+					l171:
+						goto l122
+					l123:
+						position, tokenIndex = position123, tokenIndex123
 					}
-					goto l2
-				l3:
-					position, tokenIndex = position3, tokenIndex3
-				}
-				{
-					position392 := position
-					{
-						position393, tokenIndex393 := position, tokenIndex
-						if !matchDot() {
-							goto l393
-						}
+					if !_rules[ruleEOF]() {
 						goto l0
-					l393:
-						position, tokenIndex = position393, tokenIndex393
 					}
-					add(ruleEOF, position392)
 				}
+			l2:
 				add(ruleQuery, position1)
 			}
 			return true
@@ -3367,1821 +3416,9842 @@ func (p *parser) Init(options ...func(*parser) error) error {
 			position, tokenIndex = position0, tokenIndex0
 			return false
 		},
-		/* 1 Expr <- <(NOW / RelativeMinutes / RelativeHours / RelativeDays / RelativeWeeks / RelativeWeekdays / RelativeMonth / RelativeYear / Date / Time / Word)> */
-		nil,
-		/* 2 RelativeMinutes <- <((Number MINUTES AGO Action0) / (((Number MINUTES FROM_NOW) / (In Number? MINUTES FROM_NOW?)) Action1) / (Last Number? MINUTES Action2) / (Next Number? MINUTES Action3) / (Number MINUTES Action4))> */
-		nil,
-		/* 3 RelativeHours <- <((Number HOURS AGO Action5) / (((Number HOURS FROM_NOW) / (In Number? HOURS FROM_NOW?)) Action6) / (Last Number? HOURS Action7) / (Next Number? HOURS Action8) / (Number HOURS Action9))> */
-		nil,
-		/* 4 RelativeDays <- <((Number DAYS AGO Action10) / (((Number DAYS FROM_NOW) / (In Number? DAYS FROM_NOW?)) Action11) / (Last Number? DAYS Action12) / (Next Number? DAYS Action13) / (Number DAYS Action14))> */
-		nil,
-		/* 5 RelativeWeeks <- <((Number WEEKS AGO Action15) / (((Number WEEKS FROM_NOW) / (In Number? WEEKS FROM_NOW?)) Action16) / (Last Number? WEEKS Action17) / (Next Number? WEEKS Action18) / (Number WEEKS Action19))> */
-		nil,
-		/* 6 RelativeMonth <- <((Number MONTHS AGO Action20) / (((Number MONTHS FROM_NOW) / (In Number? MONTHS FROM_NOW?)) Action21) / (Last Number? MONTHS Action22) / (Next Number? MONTHS Action23) / (LAST Month Action24) / (NEXT Month Action25) / (Month Action26))> */
-		nil,
-		/* 7 RelativeYear <- <((Number YEARS AGO Action27) / (((Number YEARS FROM_NOW) / (In Number? YEARS FROM_NOW?)) Action28) / (Last Number? YEARS Action29) / (Next Number? YEARS Action30) / (LAST YEARS Action31) / (NEXT YEARS Action32))> */
-		nil,
-		/* 8 RelativeWeekdays <- <((TODAY Action33) / (TOMORROW Action35) / ((&('n') (NEXT Weekday Action37)) | (&('y') (YESTERDAY Action34)) | (&('l' | 'p') (LAST Weekday Action36)) | (&('f' | 'm' | 's' | 't' | 'w') (Weekday Action38))))> */
-		nil,
-		/* 9 Date <- <(((Number Ordinal) / (Last Number? Number)) Action39)> */
-		nil,
-		/* 10 Time <- <(Clock12Hour / Clock24Hour)> */
-		nil,
-		/* 11 Clock12Hour <- <((Number Action40 (Minutes Seconds?)? AM) / (Number Action41 (Minutes Seconds?)? PM))> */
-		nil,
-		/* 12 Clock24Hour <- <(Number Action42 (Minutes Seconds?)?)> */
-		nil,
-		/* 13 Minutes <- <(':' Number Action43)> */
-		func() bool {
-			position406, tokenIndex406 := position, tokenIndex
-			{
-				position407 := position
-				if buffer[position] != rune(':') {
-					goto l406
-				}
-				position++
-				if !_rules[ruleNumber]() {
-					goto l406
-				}
-				{
-					add(ruleAction43, position)
-				}
-				add(ruleMinutes, position407)
-			}
-			return true
-		l406:
-			position, tokenIndex = position406, tokenIndex406
-			return false
-		},
-		/* 14 Seconds <- <(':' Number Action44)> */
+		/* 1 Expr <- <(Moment / Time / Word)> */
 		func() bool {
-			position409, tokenIndex409 := position, tokenIndex
+			position218, tokenIndex218 := position, tokenIndex
 			{
-				position410 := position
-				if buffer[position] != rune(':') {
-					goto l409
-				}
-				position++
-				if !_rules[ruleNumber]() {
-					goto l409
-				}
+				position219 := position
 				{
-					add(ruleAction44, position)
+					position220, tokenIndex220 := position, tokenIndex
+					if !_rules[ruleMoment]() {
+						goto l221
+					}
+					goto l220
+				l221:
+					position, tokenIndex = position220, tokenIndex220
+					if !_rules[ruleTime]() {
+						goto l222
+					}
+					goto l220
+				l222:
+					position, tokenIndex = position220, tokenIndex220
+					if !_rules[ruleWord]() {
+						goto l218
+					}
 				}
-				add(ruleSeconds, position410)
+			l220:
+				add(ruleExpr, position219)
 			}
 			return true
-		l409:
-			position, tokenIndex = position409, tokenIndex409
+		l218:
+			position, tokenIndex = position218, tokenIndex218
 			return false
 		},
-		/* 15 Number <- <(('t' 'w' 'o' _ Action47) / ('t' 'h' 'r' 'e' 'e' _ Action48) / ('f' 'o' 'u' 'r' _ Action49) / ('s' 'i' 'x' _ Action51) / ((&('t') ('t' 'e' 'n' _ Action55)) | (&('n') ('n' 'i' 'n' 'e' _ Action54)) | (&('e') ('e' 'i' 'g' 'h' 't' _ Action53)) | (&('s') ('s' 'e' 'v' 'e' 'n' _ Action52)) | (&('f') ('f' 'i' 'v' 'e' _ Action50)) | (&('o') ('o' 'n' 'e' _ Action46)) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') (<[0-9]+> _ Action45))))> */
+		/* 2 Moment <- <(NOW / Boundary / Holiday / Week / NthWeekday / NthDay / Offset / WeekdayInWeek / RelativeWeekdays / RelativeBusinessDays / RelativeBusinessHours / RelativeMinutes / RelativeHours / RelativeDays / RelativeWeeks / RelativeMonth / RelativeYear / Date)> */
 		func() bool {
-			position412, tokenIndex412 := position, tokenIndex
+			position223, tokenIndex223 := position, tokenIndex
 			{
-				position413 := position
+				position224 := position
 				{
-					position414, tokenIndex414 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l415
-					}
-					position++
-					if buffer[position] != rune('w') {
-						goto l415
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l415
-					}
-					position++
-					if !_rules[rule_]() {
-						goto l415
-					}
-					{
-						add(ruleAction47, position)
-					}
-					goto l414
-				l415:
-					position, tokenIndex = position414, tokenIndex414
-					if buffer[position] != rune('t') {
-						goto l417
-					}
-					position++
-					if buffer[position] != rune('h') {
-						goto l417
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l417
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l417
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l417
-					}
-					position++
-					if !_rules[rule_]() {
-						goto l417
-					}
-					{
-						add(ruleAction48, position)
-					}
-					goto l414
-				l417:
-					position, tokenIndex = position414, tokenIndex414
-					if buffer[position] != rune('f') {
-						goto l419
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l419
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l419
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l419
-					}
-					position++
-					if !_rules[rule_]() {
-						goto l419
-					}
-					{
-						add(ruleAction49, position)
-					}
-					goto l414
-				l419:
-					position, tokenIndex = position414, tokenIndex414
-					if buffer[position] != rune('s') {
-						goto l421
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l421
-					}
-					position++
-					if buffer[position] != rune('x') {
-						goto l421
-					}
-					position++
-					if !_rules[rule_]() {
-						goto l421
-					}
+					position225, tokenIndex225 := position, tokenIndex
 					{
-						add(ruleAction51, position)
+						position227 := position
+						if buffer[position] != rune('n') {
+							goto l226
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l226
+						}
+						position++
+						if buffer[position] != rune('w') {
+							goto l226
+						}
+						position++
+						if !_rules[rule_]() {
+							goto l226
+						}
+						add(ruleNOW, position227)
 					}
-					goto l414
-				l421:
-					position, tokenIndex = position414, tokenIndex414
+					goto l225
+				l226:
+					position, tokenIndex = position225, tokenIndex225
 					{
-						switch buffer[position] {
-						case 't':
-							if buffer[position] != rune('t') {
-								goto l412
-							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l412
+						position229 := position
+						{
+							position230, tokenIndex230 := position, tokenIndex
+							{
+								position232 := position
+								if buffer[position] != rune('e') {
+									goto l231
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l231
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l231
+								}
+								position++
+								if !_rules[rule_]() {
+									goto l231
+								}
+								if buffer[position] != rune('o') {
+									goto l231
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l231
+								}
+								position++
+								if !_rules[rule_]() {
+									goto l231
+								}
+								add(ruleEND, position232)
 							}
-							position++
-							if buffer[position] != rune('n') {
-								goto l412
+							if !_rules[rulePeriod]() {
+								goto l231
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l412
+							{
+								add(ruleAction46, position)
 							}
+							goto l230
+						l231:
+							position, tokenIndex = position230, tokenIndex230
 							{
-								add(ruleAction55, position)
+								switch buffer[position] {
+								case 'c':
+									{
+										position235 := position
+										{
+											position236, tokenIndex236 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l237
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l237
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l237
+											}
+											position++
+											{
+												position238, tokenIndex238 := position, tokenIndex
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l238
+												}
+												position++
+												goto l237
+											l238:
+												position, tokenIndex = position238, tokenIndex238
+											}
+											goto l236
+										l237:
+											position, tokenIndex = position236, tokenIndex236
+											if buffer[position] != rune('c') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune(' ') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('f') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune(' ') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l228
+											}
+											position++
+										}
+									l236:
+										if !_rules[rule_]() {
+											goto l228
+										}
+										add(ruleCOB, position235)
+									}
+									{
+										add(ruleAction48, position)
+									}
+								case 'e':
+									{
+										position240 := position
+										if buffer[position] != rune('e') {
+											goto l228
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l228
+										}
+										position++
+										if buffer[position] != rune('d') {
+											goto l228
+										}
+										position++
+										{
+											position241, tokenIndex241 := position, tokenIndex
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												goto l241
+											}
+											position++
+											goto l228
+										l241:
+											position, tokenIndex = position241, tokenIndex241
+										}
+										if !_rules[rule_]() {
+											goto l228
+										}
+										add(ruleEOD, position240)
+									}
+									{
+										add(ruleAction47, position)
+									}
+								default:
+									{
+										position243 := position
+										{
+											position244, tokenIndex244 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l245
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l245
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l245
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l245
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l245
+											}
+											position++
+											goto l244
+										l245:
+											position, tokenIndex = position244, tokenIndex244
+											if buffer[position] != rune('b') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('g') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l228
+											}
+											position++
+											if buffer[position] != rune('g') {
+												goto l228
+											}
+											position++
+										}
+									l244:
+										if !_rules[rule_]() {
+											goto l228
+										}
+										if buffer[position] != rune('o') {
+											goto l228
+										}
+										position++
+										if buffer[position] != rune('f') {
+											goto l228
+										}
+										position++
+										if !_rules[rule_]() {
+											goto l228
+										}
+										add(ruleSTART, position243)
+									}
+									if !_rules[rulePeriod]() {
+										goto l228
+									}
+									{
+										add(ruleAction45, position)
+									}
+								}
 							}
-							break
-						case 'n':
-							if buffer[position] != rune('n') {
-								goto l412
+
+						}
+					l230:
+						add(ruleBoundary, position229)
+					}
+					goto l225
+				l228:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position248 := position
+						{
+							position249, tokenIndex249 := position, tokenIndex
+							if !_rules[ruleLAST]() {
+								goto l250
 							}
-							position++
-							if buffer[position] != rune('i') {
-								goto l412
+							if !_rules[ruleHolidayName]() {
+								goto l250
 							}
-							position++
-							if buffer[position] != rune('n') {
-								goto l412
+							{
+								add(ruleAction62, position)
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l412
+							goto l249
+						l250:
+							position, tokenIndex = position249, tokenIndex249
+							if !_rules[ruleNEXT]() {
+								goto l252
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l412
+							if !_rules[ruleHolidayName]() {
+								goto l252
 							}
 							{
-								add(ruleAction54, position)
+								add(ruleAction63, position)
 							}
-							break
-						case 'e':
-							if buffer[position] != rune('e') {
-								goto l412
+							goto l249
+						l252:
+							position, tokenIndex = position249, tokenIndex249
+							if !_rules[ruleTHIS]() {
+								goto l254
 							}
-							position++
-							if buffer[position] != rune('i') {
-								goto l412
+							if !_rules[ruleHolidayName]() {
+								goto l254
 							}
-							position++
-							if buffer[position] != rune('g') {
-								goto l412
+							{
+								add(ruleAction64, position)
 							}
-							position++
-							if buffer[position] != rune('h') {
-								goto l412
+							goto l249
+						l254:
+							position, tokenIndex = position249, tokenIndex249
+							if !_rules[ruleHolidayName]() {
+								goto l256
 							}
-							position++
-							if buffer[position] != rune('t') {
-								goto l412
+							if !_rules[ruleYear]() {
+								goto l256
+							}
+							{
+								position257, tokenIndex257 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l257
+								}
+								position++
+								goto l256
+							l257:
+								position, tokenIndex = position257, tokenIndex257
 							}
-							position++
 							if !_rules[rule_]() {
-								goto l412
+								goto l256
 							}
 							{
-								add(ruleAction53, position)
+								add(ruleAction65, position)
 							}
-							break
-						case 's':
-							if buffer[position] != rune('s') {
-								goto l412
+							goto l249
+						l256:
+							position, tokenIndex = position249, tokenIndex249
+							if !_rules[ruleHolidayName]() {
+								goto l247
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l412
+							{
+								add(ruleAction66, position)
 							}
-							position++
-							if buffer[position] != rune('v') {
-								goto l412
+						}
+					l249:
+						add(ruleHoliday, position248)
+					}
+					goto l225
+				l247:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position261 := position
+						{
+							position262, tokenIndex262 := position, tokenIndex
+							if !_rules[ruleYear]() {
+								goto l263
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l412
+							{
+								position264, tokenIndex264 := position, tokenIndex
+								if buffer[position] != rune('-') {
+									goto l264
+								}
+								position++
+								goto l265
+							l264:
+								position, tokenIndex = position264, tokenIndex264
 							}
-							position++
-							if buffer[position] != rune('n') {
-								goto l412
+						l265:
+							if buffer[position] != rune('w') {
+								goto l263
 							}
 							position++
-							if !_rules[rule_]() {
-								goto l412
+							if !_rules[ruleWeekNumber]() {
+								goto l263
 							}
 							{
-								add(ruleAction52, position)
+								position266, tokenIndex266 := position, tokenIndex
+								if buffer[position] != rune('-') {
+									goto l266
+								}
+								position++
+								goto l267
+							l266:
+								position, tokenIndex = position266, tokenIndex266
 							}
-							break
-						case 'f':
-							if buffer[position] != rune('f') {
-								goto l412
+						l267:
+							{
+								position268 := position
+								if c := buffer[position]; c < rune('1') || c > rune('7') {
+									goto l263
+								}
+								position++
+								add(rulePegText, position268)
 							}
-							position++
-							if buffer[position] != rune('i') {
-								goto l412
+							{
+								position269, tokenIndex269 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l269
+								}
+								position++
+								goto l263
+							l269:
+								position, tokenIndex = position269, tokenIndex269
 							}
-							position++
-							if buffer[position] != rune('v') {
-								goto l412
+							if !_rules[rule_]() {
+								goto l263
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l412
+							{
+								add(ruleAction67, position)
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l412
+							goto l262
+						l263:
+							position, tokenIndex = position262, tokenIndex262
+							if !_rules[ruleYear]() {
+								goto l271
 							}
 							{
-								add(ruleAction50, position)
+								position272, tokenIndex272 := position, tokenIndex
+								if buffer[position] != rune('-') {
+									goto l272
+								}
+								position++
+								goto l273
+							l272:
+								position, tokenIndex = position272, tokenIndex272
 							}
-							break
-						case 'o':
-							if buffer[position] != rune('o') {
-								goto l412
+						l273:
+							if buffer[position] != rune('w') {
+								goto l271
 							}
 							position++
-							if buffer[position] != rune('n') {
-								goto l412
+							if !_rules[ruleWeekNumber]() {
+								goto l271
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l412
+							{
+								position274, tokenIndex274 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l274
+								}
+								position++
+								goto l271
+							l274:
+								position, tokenIndex = position274, tokenIndex274
 							}
-							position++
 							if !_rules[rule_]() {
-								goto l412
+								goto l271
 							}
 							{
-								add(ruleAction46, position)
+								add(ruleAction68, position)
 							}
-							break
-						default:
+							goto l262
+						l271:
+							position, tokenIndex = position262, tokenIndex262
 							{
-								position430 := position
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l412
+								position277, tokenIndex277 := position, tokenIndex
+								if buffer[position] != rune('w') {
+									goto l278
 								}
 								position++
-							l431:
-								{
-									position432, tokenIndex432 := position, tokenIndex
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l432
-									}
-									position++
-									goto l431
-								l432:
-									position, tokenIndex = position432, tokenIndex432
+								if buffer[position] != rune('e') {
+									goto l278
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l278
 								}
-								add(rulePegText, position430)
+								position++
+								if buffer[position] != rune('k') {
+									goto l278
+								}
+								position++
+								if !_rules[rule_]() {
+									goto l278
+								}
+								goto l277
+							l278:
+								position, tokenIndex = position277, tokenIndex277
+								if buffer[position] != rune('w') {
+									goto l276
+								}
+								position++
 							}
-							if !_rules[rule_]() {
-								goto l412
+						l277:
+							if !_rules[ruleWeekNumber]() {
+								goto l276
 							}
 							{
-								add(ruleAction45, position)
-							}
-							break
-						}
-					}
-
-				}
-			l414:
-				add(ruleNumber, position413)
-			}
-			return true
-		l412:
-			position, tokenIndex = position412, tokenIndex412
-			return false
-		},
-		/* 16 Weekday <- <(('s' 'u' 'n' 'd' 'a' 'y' _ Action56) / ('t' 'u' 'e' 's' 'd' 'a' 'y' _ Action58) / ((&('s') ('s' 'a' 't' 'u' 'r' 'd' 'a' 'y' _ Action62)) | (&('f') ('f' 'r' 'i' 'd' 'a' 'y' _ Action61)) | (&('t') ('t' 'h' 'u' 'r' 's' 'd' 'a' 'y' _ Action60)) | (&('w') ('w' 'e' 'd' 'n' 'e' 's' 'd' 'a' 'y' _ Action59)) | (&('m') ('m' 'o' 'n' 'd' 'a' 'y' _ Action57))))> */
-		func() bool {
-			position434, tokenIndex434 := position, tokenIndex
-			{
-				position435 := position
-				{
-					position436, tokenIndex436 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l437
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l437
-					}
-					position++
-					if buffer[position] != rune('n') {
-						goto l437
-					}
-					position++
-					if buffer[position] != rune('d') {
-						goto l437
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l437
-					}
-					position++
-					if buffer[position] != rune('y') {
-						goto l437
-					}
-					position++
-					if !_rules[rule_]() {
-						goto l437
-					}
-					{
-						add(ruleAction56, position)
-					}
-					goto l436
-				l437:
-					position, tokenIndex = position436, tokenIndex436
-					if buffer[position] != rune('t') {
-						goto l439
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l439
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l439
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l439
-					}
-					position++
-					if buffer[position] != rune('d') {
-						goto l439
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l439
-					}
-					position++
-					if buffer[position] != rune('y') {
-						goto l439
-					}
-					position++
-					if !_rules[rule_]() {
-						goto l439
-					}
-					{
-						add(ruleAction58, position)
-					}
-					goto l436
-				l439:
-					position, tokenIndex = position436, tokenIndex436
-					{
-						switch buffer[position] {
-						case 's':
-							if buffer[position] != rune('s') {
-								goto l434
+								position279, tokenIndex279 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l279
+								}
+								position++
+								goto l276
+							l279:
+								position, tokenIndex = position279, tokenIndex279
 							}
-							position++
-							if buffer[position] != rune('a') {
-								goto l434
+							if !_rules[rule_]() {
+								goto l276
 							}
-							position++
-							if buffer[position] != rune('t') {
-								goto l434
+							{
+								position280, tokenIndex280 := position, tokenIndex
+								if !_rules[ruleOF]() {
+									goto l280
+								}
+								goto l281
+							l280:
+								position, tokenIndex = position280, tokenIndex280
 							}
-							position++
-							if buffer[position] != rune('u') {
-								goto l434
+						l281:
+							if !_rules[ruleYear]() {
+								goto l276
 							}
-							position++
-							if buffer[position] != rune('r') {
-								goto l434
+							{
+								position282, tokenIndex282 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l282
+								}
+								position++
+								goto l276
+							l282:
+								position, tokenIndex = position282, tokenIndex282
 							}
-							position++
-							if buffer[position] != rune('d') {
-								goto l434
+							if !_rules[rule_]() {
+								goto l276
 							}
-							position++
-							if buffer[position] != rune('a') {
-								goto l434
+							{
+								add(ruleAction69, position)
 							}
-							position++
-							if buffer[position] != rune('y') {
-								goto l434
+							goto l262
+						l276:
+							position, tokenIndex = position262, tokenIndex262
+							{
+								position285, tokenIndex285 := position, tokenIndex
+								if buffer[position] != rune('w') {
+									goto l286
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l286
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l286
+								}
+								position++
+								if buffer[position] != rune('k') {
+									goto l286
+								}
+								position++
+								if !_rules[rule_]() {
+									goto l286
+								}
+								goto l285
+							l286:
+								position, tokenIndex = position285, tokenIndex285
+								if buffer[position] != rune('w') {
+									goto l284
+								}
+								position++
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l434
+						l285:
+							if !_rules[ruleWeekNumber]() {
+								goto l284
 							}
 							{
-								add(ruleAction62, position)
+								position287, tokenIndex287 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l287
+								}
+								position++
+								goto l284
+							l287:
+								position, tokenIndex = position287, tokenIndex287
 							}
-							break
-						case 'f':
-							if buffer[position] != rune('f') {
-								goto l434
+							if !_rules[rule_]() {
+								goto l284
 							}
-							position++
-							if buffer[position] != rune('r') {
-								goto l434
+							{
+								add(ruleAction70, position)
 							}
-							position++
-							if buffer[position] != rune('i') {
-								goto l434
+							goto l262
+						l284:
+							position, tokenIndex = position262, tokenIndex262
+							if buffer[position] != rune('w') {
+								goto l260
 							}
 							position++
-							if buffer[position] != rune('d') {
-								goto l434
+							if buffer[position] != rune('e') {
+								goto l260
 							}
 							position++
-							if buffer[position] != rune('a') {
-								goto l434
+							if buffer[position] != rune('e') {
+								goto l260
 							}
 							position++
-							if buffer[position] != rune('y') {
-								goto l434
+							if buffer[position] != rune('k') {
+								goto l260
 							}
 							position++
 							if !_rules[rule_]() {
-								goto l434
+								goto l260
 							}
-							{
-								add(ruleAction61, position)
-							}
-							break
-						case 't':
-							if buffer[position] != rune('t') {
-								goto l434
+							if !_rules[ruleOF]() {
+								goto l260
 							}
-							position++
-							if buffer[position] != rune('h') {
-								goto l434
+							{
+								add(ruleAction71, position)
 							}
-							position++
-							if buffer[position] != rune('u') {
-								goto l434
+						}
+					l262:
+						add(ruleWeek, position261)
+					}
+					goto l225
+				l260:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position291 := position
+						if !_rules[ruleNth]() {
+							goto l290
+						}
+						if !_rules[ruleWeekday]() {
+							goto l290
+						}
+						{
+							position292, tokenIndex292 := position, tokenIndex
+							if !_rules[ruleOF]() {
+								goto l293
 							}
-							position++
-							if buffer[position] != rune('r') {
-								goto l434
+							goto l292
+						l293:
+							position, tokenIndex = position292, tokenIndex292
+							if buffer[position] != rune('i') {
+								goto l290
 							}
 							position++
-							if buffer[position] != rune('s') {
-								goto l434
+							if buffer[position] != rune('n') {
+								goto l290
 							}
 							position++
-							if buffer[position] != rune('d') {
-								goto l434
+							if !_rules[rule_]() {
+								goto l290
 							}
-							position++
-							if buffer[position] != rune('a') {
-								goto l434
+						}
+					l292:
+						{
+							add(ruleAction72, position)
+						}
+						add(ruleNthWeekday, position291)
+					}
+					goto l225
+				l290:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position296 := position
+						{
+							position297, tokenIndex297 := position, tokenIndex
+							if !_rules[ruleNth]() {
+								goto l298
 							}
-							position++
-							if buffer[position] != rune('y') {
-								goto l434
+							if !_rules[ruleBUSINESS_DAYS]() {
+								goto l298
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l434
+							if !_rules[ruleOF]() {
+								goto l298
 							}
 							{
-								add(ruleAction60, position)
+								add(ruleAction73, position)
 							}
-							break
-						case 'w':
-							if buffer[position] != rune('w') {
-								goto l434
+							goto l297
+						l298:
+							position, tokenIndex = position297, tokenIndex297
+							if !_rules[ruleNth]() {
+								goto l295
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l434
+							if !_rules[ruleDAYS]() {
+								goto l295
 							}
-							position++
-							if buffer[position] != rune('d') {
-								goto l434
+							if !_rules[ruleOF]() {
+								goto l295
 							}
-							position++
-							if buffer[position] != rune('n') {
-								goto l434
+							{
+								add(ruleAction74, position)
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l434
+						}
+					l297:
+						add(ruleNthDay, position296)
+					}
+					goto l225
+				l295:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position302 := position
+						{
+							position303, tokenIndex303 := position, tokenIndex
+							{
+								position305, tokenIndex305 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l306
+								}
+								goto l305
+							l306:
+								position, tokenIndex = position305, tokenIndex305
+								if !_rules[ruleOne]() {
+									goto l304
+								}
 							}
-							position++
-							if buffer[position] != rune('s') {
-								goto l434
+						l305:
+							if !_rules[ruleUnit]() {
+								goto l304
 							}
-							position++
-							if buffer[position] != rune('d') {
-								goto l434
+							if !_rules[ruleAFTER]() {
+								goto l304
 							}
-							position++
-							if buffer[position] != rune('a') {
-								goto l434
+							{
+								add(ruleAction93, position)
 							}
-							position++
-							if buffer[position] != rune('y') {
-								goto l434
+							goto l303
+						l304:
+							position, tokenIndex = position303, tokenIndex303
+							{
+								position308, tokenIndex308 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l309
+								}
+								goto l308
+							l309:
+								position, tokenIndex = position308, tokenIndex308
+								if !_rules[ruleOne]() {
+									goto l301
+								}
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l434
+						l308:
+							if !_rules[ruleUnit]() {
+								goto l301
 							}
 							{
-								add(ruleAction59, position)
+								position310 := position
+								if buffer[position] != rune('b') {
+									goto l301
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l301
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l301
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l301
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l301
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l301
+								}
+								position++
+								if !_rules[rule_]() {
+									goto l301
+								}
+								add(ruleBEFORE, position310)
 							}
-							break
-						default:
-							if buffer[position] != rune('m') {
-								goto l434
+							{
+								add(ruleAction94, position)
 							}
-							position++
-							if buffer[position] != rune('o') {
-								goto l434
+						}
+					l303:
+						add(ruleOffset, position302)
+					}
+					goto l225
+				l301:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position313 := position
+						{
+							position314, tokenIndex314 := position, tokenIndex
+							if !_rules[ruleWeekday]() {
+								goto l315
 							}
-							position++
-							if buffer[position] != rune('n') {
-								goto l434
+							if !_rules[ruleRelativeWeek]() {
+								goto l315
 							}
-							position++
-							if buffer[position] != rune('d') {
-								goto l434
+							{
+								add(ruleAction95, position)
 							}
-							position++
-							if buffer[position] != rune('a') {
-								goto l434
+							goto l314
+						l315:
+							position, tokenIndex = position314, tokenIndex314
+							if !_rules[ruleRelativeWeek]() {
+								goto l317
 							}
-							position++
-							if buffer[position] != rune('y') {
-								goto l434
+							{
+								position318, tokenIndex318 := position, tokenIndex
+								if !_rules[ruleON]() {
+									goto l318
+								}
+								goto l319
+							l318:
+								position, tokenIndex = position318, tokenIndex318
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l434
+						l319:
+							if !_rules[ruleWeekday]() {
+								goto l317
 							}
 							{
-								add(ruleAction57, position)
-							}
-							break
-						}
-					}
-
-				}
-			l436:
-				add(ruleWeekday, position435)
-			}
-			return true
-		l434:
-			position, tokenIndex = position434, tokenIndex434
-			return false
-		},
-		/* 17 Month <- <(('j' 'a' 'n' 'u' 'a' 'r' 'y' _ Action63) / ('m' 'a' 'r' 'c' 'h' _ Action65) / ('a' 'p' 'r' 'i' 'l' _ Action66) / ('j' 'u' 'n' 'e' _ Action68) / ((&('d') ('d' 'e' 'c' 'e' 'm' 'b' 'e' 'r' _ Action74)) | (&('n') ('n' 'o' 'v' 'e' 'm' 'b' 'e' 'r' _ Action73)) | (&('o') ('o' 'c' 't' 'o' 'b' 'e' 'r' _ Action72)) | (&('s') ('s' 'e' 'p' 't' 'e' 'm' 'b' 'e' 'r' _ Action71)) | (&('a') ('a' 'u' 'g' 'u' 's' 't' _ Action70)) | (&('j') ('j' 'u' 'l' 'y' _ Action69)) | (&('m') ('m' 'a' 'y' _ Action67)) | (&('f') ('f' 'e' 'b' 'r' 'u' 'a' 'r' 'y' _ Action64))))> */
-		func() bool {
-			position447, tokenIndex447 := position, tokenIndex
-			{
-				position448 := position
-				{
-					position449, tokenIndex449 := position, tokenIndex
-					if buffer[position] != rune('j') {
-						goto l450
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l450
-					}
-					position++
-					if buffer[position] != rune('n') {
-						goto l450
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l450
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l450
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l450
-					}
-					position++
-					if buffer[position] != rune('y') {
-						goto l450
-					}
-					position++
-					if !_rules[rule_]() {
-						goto l450
-					}
-					{
-						add(ruleAction63, position)
-					}
-					goto l449
-				l450:
-					position, tokenIndex = position449, tokenIndex449
-					if buffer[position] != rune('m') {
-						goto l452
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l452
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l452
-					}
-					position++
-					if buffer[position] != rune('c') {
-						goto l452
-					}
-					position++
-					if buffer[position] != rune('h') {
-						goto l452
-					}
-					position++
-					if !_rules[rule_]() {
-						goto l452
-					}
-					{
-						add(ruleAction65, position)
-					}
-					goto l449
-				l452:
-					position, tokenIndex = position449, tokenIndex449
-					if buffer[position] != rune('a') {
-						goto l454
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l454
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l454
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l454
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l454
-					}
-					position++
-					if !_rules[rule_]() {
-						goto l454
-					}
-					{
-						add(ruleAction66, position)
-					}
-					goto l449
-				l454:
-					position, tokenIndex = position449, tokenIndex449
-					if buffer[position] != rune('j') {
-						goto l456
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l456
-					}
-					position++
-					if buffer[position] != rune('n') {
-						goto l456
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l456
-					}
-					position++
-					if !_rules[rule_]() {
-						goto l456
-					}
-					{
-						add(ruleAction68, position)
-					}
-					goto l449
-				l456:
-					position, tokenIndex = position449, tokenIndex449
-					{
-						switch buffer[position] {
-						case 'd':
-							if buffer[position] != rune('d') {
-								goto l447
+								add(ruleAction96, position)
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('c') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('m') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('b') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l447
+							goto l314
+						l317:
+							position, tokenIndex = position314, tokenIndex314
+							if !_rules[ruleWeekday]() {
+								goto l312
 							}
-							position++
-							if buffer[position] != rune('r') {
-								goto l447
+							if !_rules[ruleAFTER]() {
+								goto l312
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l447
+							if !_rules[ruleNEXT]() {
+								goto l312
 							}
 							{
-								add(ruleAction74, position)
-							}
-							break
-						case 'n':
-							if buffer[position] != rune('n') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('o') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('v') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('m') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('b') {
-								goto l447
+								add(ruleAction97, position)
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('r') {
-								goto l447
-							}
-							position++
-							if !_rules[rule_]() {
-								goto l447
+						}
+					l314:
+						add(ruleWeekdayInWeek, position313)
+					}
+					goto l225
+				l312:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position323 := position
+						{
+							position324, tokenIndex324 := position, tokenIndex
+							if !_rules[ruleTODAY]() {
+								goto l325
 							}
 							{
-								add(ruleAction73, position)
-							}
-							break
-						case 'o':
-							if buffer[position] != rune('o') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('c') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('t') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('o') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('b') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('r') {
-								goto l447
+								add(ruleAction135, position)
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l447
+							goto l324
+						l325:
+							position, tokenIndex = position324, tokenIndex324
+							if !_rules[ruleTOMORROW]() {
+								goto l327
 							}
 							{
-								add(ruleAction72, position)
-							}
-							break
-						case 's':
-							if buffer[position] != rune('s') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('p') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('t') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('m') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('b') {
-								goto l447
+								add(ruleAction137, position)
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l447
+							goto l324
+						l327:
+							position, tokenIndex = position324, tokenIndex324
+							if !_rules[ruleNumber]() {
+								goto l329
 							}
-							position++
-							if buffer[position] != rune('r') {
-								goto l447
+							if !_rules[ruleWeekday]() {
+								goto l329
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l447
+							if !_rules[ruleAGO]() {
+								goto l329
 							}
 							{
-								add(ruleAction71, position)
-							}
-							break
-						case 'a':
-							if buffer[position] != rune('a') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('u') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('g') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('u') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('s') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('t') {
-								goto l447
-							}
-							position++
-							if !_rules[rule_]() {
-								goto l447
+								add(ruleAction138, position)
 							}
+							goto l324
+						l329:
+							position, tokenIndex = position324, tokenIndex324
 							{
-								add(ruleAction70, position)
-							}
-							break
-						case 'j':
-							if buffer[position] != rune('j') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('u') {
-								goto l447
-							}
-							position++
-							if buffer[position] != rune('l') {
-								goto l447
+								position332, tokenIndex332 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l333
+								}
+								if !_rules[ruleWeekday]() {
+									goto l333
+								}
+								if !_rules[ruleFROM_NOW]() {
+									goto l333
+								}
+								goto l332
+							l333:
+								position, tokenIndex = position332, tokenIndex332
+								if !_rules[ruleIn]() {
+									goto l331
+								}
+								if !_rules[ruleNumber]() {
+									goto l331
+								}
+								if !_rules[ruleWeekday]() {
+									goto l331
+								}
+								{
+									position334, tokenIndex334 := position, tokenIndex
+									if !_rules[ruleFROM_NOW]() {
+										goto l334
+									}
+									goto l335
+								l334:
+									position, tokenIndex = position334, tokenIndex334
+								}
+							l335:
 							}
-							position++
-							if buffer[position] != rune('y') {
-								goto l447
+						l332:
+							{
+								add(ruleAction139, position)
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l447
+							goto l324
+						l331:
+							position, tokenIndex = position324, tokenIndex324
+							if !_rules[ruleNumber]() {
+								goto l337
 							}
 							{
-								add(ruleAction69, position)
+								position338, tokenIndex338 := position, tokenIndex
+								{
+									position339 := position
+									{
+										position340, tokenIndex340 := position, tokenIndex
+										if buffer[position] != rune('s') {
+											goto l341
+										}
+										position++
+										if buffer[position] != rune('u') {
+											goto l341
+										}
+										position++
+										if buffer[position] != rune('n') {
+											goto l341
+										}
+										position++
+										goto l340
+									l341:
+										position, tokenIndex = position340, tokenIndex340
+										if buffer[position] != rune('t') {
+											goto l342
+										}
+										position++
+										if buffer[position] != rune('u') {
+											goto l342
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l342
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l342
+										}
+										position++
+										goto l340
+									l342:
+										position, tokenIndex = position340, tokenIndex340
+										{
+											switch buffer[position] {
+											case 's':
+												if buffer[position] != rune('s') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('a') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('t') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('u') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l337
+												}
+												position++
+											case 'f':
+												if buffer[position] != rune('f') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('i') {
+													goto l337
+												}
+												position++
+											case 't':
+												if buffer[position] != rune('t') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('h') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('u') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('s') {
+													goto l337
+												}
+												position++
+											case 'w':
+												if buffer[position] != rune('w') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('d') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('s') {
+													goto l337
+												}
+												position++
+											default:
+												if buffer[position] != rune('m') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('o') {
+													goto l337
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l337
+												}
+												position++
+											}
+										}
+
+									}
+								l340:
+									if buffer[position] != rune('d') {
+										goto l337
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l337
+									}
+									position++
+									if buffer[position] != rune('y') {
+										goto l337
+									}
+									position++
+									if buffer[position] != rune('s') {
+										goto l337
+									}
+									position++
+									{
+										position344, tokenIndex344 := position, tokenIndex
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l344
+										}
+										position++
+										goto l337
+									l344:
+										position, tokenIndex = position344, tokenIndex344
+									}
+									add(rulePluralWeekday, position339)
+								}
+								position, tokenIndex = position338, tokenIndex338
 							}
-							break
-						case 'm':
-							if buffer[position] != rune('m') {
-								goto l447
+							if !_rules[ruleWeekday]() {
+								goto l337
 							}
-							position++
-							if buffer[position] != rune('a') {
-								goto l447
+							{
+								add(ruleAction140, position)
 							}
-							position++
-							if buffer[position] != rune('y') {
-								goto l447
+							goto l324
+						l337:
+							position, tokenIndex = position324, tokenIndex324
+							if !_rules[ruleTHIS]() {
+								goto l346
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l447
+							if !_rules[ruleWeekday]() {
+								goto l346
 							}
 							{
-								add(ruleAction67, position)
+								add(ruleAction143, position)
 							}
-							break
-						default:
-							if buffer[position] != rune('f') {
-								goto l447
+							goto l324
+						l346:
+							position, tokenIndex = position324, tokenIndex324
+							{
+								switch buffer[position] {
+								case 'n':
+									if !_rules[ruleNEXT]() {
+										goto l322
+									}
+									if !_rules[ruleWeekday]() {
+										goto l322
+									}
+									{
+										add(ruleAction142, position)
+									}
+								case 'y':
+									if !_rules[ruleYESTERDAY]() {
+										goto l322
+									}
+									{
+										add(ruleAction136, position)
+									}
+								case 'l', 'p':
+									if !_rules[ruleLAST]() {
+										goto l322
+									}
+									if !_rules[ruleWeekday]() {
+										goto l322
+									}
+									{
+										add(ruleAction141, position)
+									}
+								default:
+									if !_rules[ruleWeekday]() {
+										goto l322
+									}
+									{
+										add(ruleAction144, position)
+									}
+								}
 							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l447
+
+						}
+					l324:
+						add(ruleRelativeWeekdays, position323)
+					}
+					goto l225
+				l322:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position354 := position
+						{
+							position355, tokenIndex355 := position, tokenIndex
+							if !_rules[ruleNumber]() {
+								goto l356
 							}
-							position++
-							if buffer[position] != rune('b') {
-								goto l447
+							if !_rules[ruleBUSINESS_DAYS]() {
+								goto l356
 							}
-							position++
-							if buffer[position] != rune('r') {
-								goto l447
+							if !_rules[ruleAGO]() {
+								goto l356
 							}
-							position++
-							if buffer[position] != rune('u') {
-								goto l447
+							{
+								add(ruleAction100, position)
 							}
-							position++
-							if buffer[position] != rune('a') {
-								goto l447
+							goto l355
+						l356:
+							position, tokenIndex = position355, tokenIndex355
+							{
+								position359, tokenIndex359 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l360
+								}
+								if !_rules[ruleBUSINESS_DAYS]() {
+									goto l360
+								}
+								if !_rules[ruleFROM_NOW]() {
+									goto l360
+								}
+								goto l359
+							l360:
+								position, tokenIndex = position359, tokenIndex359
+								if !_rules[ruleIn]() {
+									goto l358
+								}
+								{
+									position361, tokenIndex361 := position, tokenIndex
+									if !_rules[ruleNumber]() {
+										goto l361
+									}
+									goto l362
+								l361:
+									position, tokenIndex = position361, tokenIndex361
+								}
+							l362:
+								if !_rules[ruleBUSINESS_DAYS]() {
+									goto l358
+								}
+								{
+									position363, tokenIndex363 := position, tokenIndex
+									if !_rules[ruleFROM_NOW]() {
+										goto l363
+									}
+									goto l364
+								l363:
+									position, tokenIndex = position363, tokenIndex363
+								}
+							l364:
 							}
-							position++
-							if buffer[position] != rune('r') {
-								goto l447
+						l359:
+							{
+								add(ruleAction101, position)
 							}
-							position++
-							if buffer[position] != rune('y') {
-								goto l447
+							goto l355
+						l358:
+							position, tokenIndex = position355, tokenIndex355
+							if !_rules[ruleLast]() {
+								goto l366
 							}
-							position++
-							if !_rules[rule_]() {
-								goto l447
+							{
+								position367, tokenIndex367 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l367
+								}
+								goto l368
+							l367:
+								position, tokenIndex = position367, tokenIndex367
+							}
+						l368:
+							if !_rules[ruleBUSINESS_DAYS]() {
+								goto l366
 							}
 							{
-								add(ruleAction64, position)
+								add(ruleAction102, position)
+							}
+							goto l355
+						l366:
+							position, tokenIndex = position355, tokenIndex355
+							if !_rules[ruleNext]() {
+								goto l370
+							}
+							{
+								position371, tokenIndex371 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l371
+								}
+								goto l372
+							l371:
+								position, tokenIndex = position371, tokenIndex371
+							}
+						l372:
+							if !_rules[ruleBUSINESS_DAYS]() {
+								goto l370
+							}
+							{
+								add(ruleAction103, position)
+							}
+							goto l355
+						l370:
+							position, tokenIndex = position355, tokenIndex355
+							if !_rules[ruleNumber]() {
+								goto l353
+							}
+							if !_rules[ruleBUSINESS_DAYS]() {
+								goto l353
+							}
+							{
+								add(ruleAction104, position)
 							}
-							break
 						}
+					l355:
+						add(ruleRelativeBusinessDays, position354)
 					}
-
-				}
-			l449:
-				add(ruleMonth, position448)
-			}
-			return true
-		l447:
-			position, tokenIndex = position447, tokenIndex447
-			return false
-		},
-		/* 18 In <- <(IN Action75)> */
-		func() bool {
-			position467, tokenIndex467 := position, tokenIndex
-			{
-				position468 := position
-				{
-					position469 := position
+					goto l225
+				l353:
+					position, tokenIndex = position225, tokenIndex225
 					{
-						position470, tokenIndex470 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l471
-						}
-						position++
-						if buffer[position] != rune('n') {
-							goto l471
-						}
-						position++
-						if buffer[position] != rune(' ') {
-							goto l471
-						}
-						position++
-						if buffer[position] != rune('a') {
-							goto l471
-						}
-						position++
-						if buffer[position] != rune('n') {
-							goto l471
-						}
-						position++
-						goto l470
-					l471:
-						position, tokenIndex = position470, tokenIndex470
-						if buffer[position] != rune('i') {
-							goto l472
-						}
-						position++
-						if buffer[position] != rune('n') {
-							goto l472
-						}
-						position++
-						if buffer[position] != rune(' ') {
-							goto l472
-						}
-						position++
-						if buffer[position] != rune('a') {
-							goto l472
-						}
-						position++
-						goto l470
-					l472:
-						position, tokenIndex = position470, tokenIndex470
-						if buffer[position] != rune('i') {
-							goto l467
-						}
-						position++
-						if buffer[position] != rune('n') {
-							goto l467
-						}
-						position++
+						position376 := position
+						{
+							position377, tokenIndex377 := position, tokenIndex
+							if !_rules[ruleNumber]() {
+								goto l378
+							}
+							if !_rules[ruleBUSINESS_MINUTES]() {
+								goto l378
+							}
+							if !_rules[ruleAGO]() {
+								goto l378
+							}
+							{
+								add(ruleAction105, position)
+							}
+							goto l377
+						l378:
+							position, tokenIndex = position377, tokenIndex377
+							{
+								position381, tokenIndex381 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l382
+								}
+								if !_rules[ruleBUSINESS_MINUTES]() {
+									goto l382
+								}
+								if !_rules[ruleFROM_NOW]() {
+									goto l382
+								}
+								goto l381
+							l382:
+								position, tokenIndex = position381, tokenIndex381
+								if !_rules[ruleIn]() {
+									goto l380
+								}
+								{
+									position383, tokenIndex383 := position, tokenIndex
+									if !_rules[ruleNumber]() {
+										goto l383
+									}
+									goto l384
+								l383:
+									position, tokenIndex = position383, tokenIndex383
+								}
+							l384:
+								if !_rules[ruleBUSINESS_MINUTES]() {
+									goto l380
+								}
+								{
+									position385, tokenIndex385 := position, tokenIndex
+									if !_rules[ruleFROM_NOW]() {
+										goto l385
+									}
+									goto l386
+								l385:
+									position, tokenIndex = position385, tokenIndex385
+								}
+							l386:
+							}
+						l381:
+							{
+								add(ruleAction106, position)
+							}
+							goto l377
+						l380:
+							position, tokenIndex = position377, tokenIndex377
+							if !_rules[ruleNumber]() {
+								goto l388
+							}
+							if !_rules[ruleBUSINESS_MINUTES]() {
+								goto l388
+							}
+							{
+								add(ruleAction107, position)
+							}
+							goto l377
+						l388:
+							position, tokenIndex = position377, tokenIndex377
+							if !_rules[ruleNumber]() {
+								goto l390
+							}
+							if !_rules[ruleBUSINESS_HOURS]() {
+								goto l390
+							}
+							if !_rules[ruleAGO]() {
+								goto l390
+							}
+							{
+								add(ruleAction108, position)
+							}
+							goto l377
+						l390:
+							position, tokenIndex = position377, tokenIndex377
+							{
+								position393, tokenIndex393 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l394
+								}
+								if !_rules[ruleBUSINESS_HOURS]() {
+									goto l394
+								}
+								if !_rules[ruleFROM_NOW]() {
+									goto l394
+								}
+								goto l393
+							l394:
+								position, tokenIndex = position393, tokenIndex393
+								if !_rules[ruleIn]() {
+									goto l392
+								}
+								{
+									position395, tokenIndex395 := position, tokenIndex
+									if !_rules[ruleNumber]() {
+										goto l395
+									}
+									goto l396
+								l395:
+									position, tokenIndex = position395, tokenIndex395
+								}
+							l396:
+								if !_rules[ruleBUSINESS_HOURS]() {
+									goto l392
+								}
+								{
+									position397, tokenIndex397 := position, tokenIndex
+									if !_rules[ruleFROM_NOW]() {
+										goto l397
+									}
+									goto l398
+								l397:
+									position, tokenIndex = position397, tokenIndex397
+								}
+							l398:
+							}
+						l393:
+							{
+								add(ruleAction109, position)
+							}
+							goto l377
+						l392:
+							position, tokenIndex = position377, tokenIndex377
+							if !_rules[ruleNumber]() {
+								goto l375
+							}
+							if !_rules[ruleBUSINESS_HOURS]() {
+								goto l375
+							}
+							{
+								add(ruleAction110, position)
+							}
+						}
+					l377:
+						add(ruleRelativeBusinessHours, position376)
+					}
+					goto l225
+				l375:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position402 := position
+						{
+							position403, tokenIndex403 := position, tokenIndex
+							if !_rules[ruleNumber]() {
+								goto l404
+							}
+							if !_rules[ruleMINUTES]() {
+								goto l404
+							}
+							if !_rules[ruleAGO]() {
+								goto l404
+							}
+							{
+								add(ruleAction75, position)
+							}
+							goto l403
+						l404:
+							position, tokenIndex = position403, tokenIndex403
+							{
+								position407, tokenIndex407 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l408
+								}
+								if !_rules[ruleMINUTES]() {
+									goto l408
+								}
+								if !_rules[ruleFROM_NOW]() {
+									goto l408
+								}
+								goto l407
+							l408:
+								position, tokenIndex = position407, tokenIndex407
+								if !_rules[ruleIn]() {
+									goto l406
+								}
+								{
+									position409, tokenIndex409 := position, tokenIndex
+									if !_rules[ruleNumber]() {
+										goto l409
+									}
+									goto l410
+								l409:
+									position, tokenIndex = position409, tokenIndex409
+								}
+							l410:
+								if !_rules[ruleMINUTES]() {
+									goto l406
+								}
+								{
+									position411, tokenIndex411 := position, tokenIndex
+									if !_rules[ruleFROM_NOW]() {
+										goto l411
+									}
+									goto l412
+								l411:
+									position, tokenIndex = position411, tokenIndex411
+								}
+							l412:
+							}
+						l407:
+							{
+								add(ruleAction76, position)
+							}
+							goto l403
+						l406:
+							position, tokenIndex = position403, tokenIndex403
+							if !_rules[ruleLast]() {
+								goto l414
+							}
+							{
+								position415, tokenIndex415 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l415
+								}
+								goto l416
+							l415:
+								position, tokenIndex = position415, tokenIndex415
+							}
+						l416:
+							if !_rules[ruleMINUTES]() {
+								goto l414
+							}
+							{
+								add(ruleAction77, position)
+							}
+							goto l403
+						l414:
+							position, tokenIndex = position403, tokenIndex403
+							if !_rules[ruleNext]() {
+								goto l418
+							}
+							{
+								position419, tokenIndex419 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l419
+								}
+								goto l420
+							l419:
+								position, tokenIndex = position419, tokenIndex419
+							}
+						l420:
+							if !_rules[ruleMINUTES]() {
+								goto l418
+							}
+							{
+								add(ruleAction78, position)
+							}
+							goto l403
+						l418:
+							position, tokenIndex = position403, tokenIndex403
+							if !_rules[ruleTHIS]() {
+								goto l422
+							}
+							if !_rules[ruleMINUTES]() {
+								goto l422
+							}
+							{
+								add(ruleAction79, position)
+							}
+							goto l403
+						l422:
+							position, tokenIndex = position403, tokenIndex403
+							if !_rules[ruleNumber]() {
+								goto l401
+							}
+							if !_rules[ruleMINUTES]() {
+								goto l401
+							}
+							{
+								add(ruleAction80, position)
+							}
+						}
+					l403:
+						add(ruleRelativeMinutes, position402)
+					}
+					goto l225
+				l401:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position426 := position
+						{
+							position427, tokenIndex427 := position, tokenIndex
+							if !_rules[ruleNumber]() {
+								goto l428
+							}
+							if !_rules[ruleHOURS]() {
+								goto l428
+							}
+							if !_rules[ruleAGO]() {
+								goto l428
+							}
+							{
+								add(ruleAction81, position)
+							}
+							goto l427
+						l428:
+							position, tokenIndex = position427, tokenIndex427
+							{
+								position431, tokenIndex431 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l432
+								}
+								if !_rules[ruleHOURS]() {
+									goto l432
+								}
+								if !_rules[ruleFROM_NOW]() {
+									goto l432
+								}
+								goto l431
+							l432:
+								position, tokenIndex = position431, tokenIndex431
+								if !_rules[ruleIn]() {
+									goto l430
+								}
+								{
+									position433, tokenIndex433 := position, tokenIndex
+									if !_rules[ruleNumber]() {
+										goto l433
+									}
+									goto l434
+								l433:
+									position, tokenIndex = position433, tokenIndex433
+								}
+							l434:
+								if !_rules[ruleHOURS]() {
+									goto l430
+								}
+								{
+									position435, tokenIndex435 := position, tokenIndex
+									if !_rules[ruleFROM_NOW]() {
+										goto l435
+									}
+									goto l436
+								l435:
+									position, tokenIndex = position435, tokenIndex435
+								}
+							l436:
+							}
+						l431:
+							{
+								add(ruleAction82, position)
+							}
+							goto l427
+						l430:
+							position, tokenIndex = position427, tokenIndex427
+							if !_rules[ruleLast]() {
+								goto l438
+							}
+							{
+								position439, tokenIndex439 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l439
+								}
+								goto l440
+							l439:
+								position, tokenIndex = position439, tokenIndex439
+							}
+						l440:
+							if !_rules[ruleHOURS]() {
+								goto l438
+							}
+							{
+								add(ruleAction83, position)
+							}
+							goto l427
+						l438:
+							position, tokenIndex = position427, tokenIndex427
+							if !_rules[ruleNext]() {
+								goto l442
+							}
+							{
+								position443, tokenIndex443 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l443
+								}
+								goto l444
+							l443:
+								position, tokenIndex = position443, tokenIndex443
+							}
+						l444:
+							if !_rules[ruleHOURS]() {
+								goto l442
+							}
+							{
+								add(ruleAction84, position)
+							}
+							goto l427
+						l442:
+							position, tokenIndex = position427, tokenIndex427
+							if !_rules[ruleTHIS]() {
+								goto l446
+							}
+							if !_rules[ruleHOURS]() {
+								goto l446
+							}
+							{
+								add(ruleAction85, position)
+							}
+							goto l427
+						l446:
+							position, tokenIndex = position427, tokenIndex427
+							if !_rules[ruleNumber]() {
+								goto l425
+							}
+							if !_rules[ruleHOURS]() {
+								goto l425
+							}
+							{
+								add(ruleAction86, position)
+							}
+						}
+					l427:
+						add(ruleRelativeHours, position426)
+					}
+					goto l225
+				l425:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position450 := position
+						{
+							position451, tokenIndex451 := position, tokenIndex
+							if !_rules[ruleNumber]() {
+								goto l452
+							}
+							if !_rules[ruleDAYS]() {
+								goto l452
+							}
+							if !_rules[ruleAGO]() {
+								goto l452
+							}
+							{
+								add(ruleAction87, position)
+							}
+							goto l451
+						l452:
+							position, tokenIndex = position451, tokenIndex451
+							{
+								position455, tokenIndex455 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l456
+								}
+								if !_rules[ruleDAYS]() {
+									goto l456
+								}
+								if !_rules[ruleFROM_NOW]() {
+									goto l456
+								}
+								goto l455
+							l456:
+								position, tokenIndex = position455, tokenIndex455
+								if !_rules[ruleIn]() {
+									goto l454
+								}
+								{
+									position457, tokenIndex457 := position, tokenIndex
+									if !_rules[ruleNumber]() {
+										goto l457
+									}
+									goto l458
+								l457:
+									position, tokenIndex = position457, tokenIndex457
+								}
+							l458:
+								if !_rules[ruleDAYS]() {
+									goto l454
+								}
+								{
+									position459, tokenIndex459 := position, tokenIndex
+									if !_rules[ruleFROM_NOW]() {
+										goto l459
+									}
+									goto l460
+								l459:
+									position, tokenIndex = position459, tokenIndex459
+								}
+							l460:
+							}
+						l455:
+							{
+								add(ruleAction88, position)
+							}
+							goto l451
+						l454:
+							position, tokenIndex = position451, tokenIndex451
+							if !_rules[ruleLast]() {
+								goto l462
+							}
+							{
+								position463, tokenIndex463 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l463
+								}
+								goto l464
+							l463:
+								position, tokenIndex = position463, tokenIndex463
+							}
+						l464:
+							if !_rules[ruleDAYS]() {
+								goto l462
+							}
+							{
+								add(ruleAction89, position)
+							}
+							goto l451
+						l462:
+							position, tokenIndex = position451, tokenIndex451
+							if !_rules[ruleNext]() {
+								goto l466
+							}
+							{
+								position467, tokenIndex467 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l467
+								}
+								goto l468
+							l467:
+								position, tokenIndex = position467, tokenIndex467
+							}
+						l468:
+							if !_rules[ruleDAYS]() {
+								goto l466
+							}
+							{
+								add(ruleAction90, position)
+							}
+							goto l451
+						l466:
+							position, tokenIndex = position451, tokenIndex451
+							if !_rules[ruleTHIS]() {
+								goto l470
+							}
+							if !_rules[ruleDAYS]() {
+								goto l470
+							}
+							{
+								add(ruleAction91, position)
+							}
+							goto l451
+						l470:
+							position, tokenIndex = position451, tokenIndex451
+							if !_rules[ruleNumber]() {
+								goto l449
+							}
+							if !_rules[ruleDAYS]() {
+								goto l449
+							}
+							{
+								add(ruleAction92, position)
+							}
+						}
+					l451:
+						add(ruleRelativeDays, position450)
+					}
+					goto l225
+				l449:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position474 := position
+						{
+							position475, tokenIndex475 := position, tokenIndex
+							if !_rules[ruleNumber]() {
+								goto l476
+							}
+							if !_rules[ruleWEEKS]() {
+								goto l476
+							}
+							if !_rules[ruleAGO]() {
+								goto l476
+							}
+							{
+								add(ruleAction111, position)
+							}
+							goto l475
+						l476:
+							position, tokenIndex = position475, tokenIndex475
+							{
+								position479, tokenIndex479 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l480
+								}
+								if !_rules[ruleWEEKS]() {
+									goto l480
+								}
+								if !_rules[ruleFROM_NOW]() {
+									goto l480
+								}
+								goto l479
+							l480:
+								position, tokenIndex = position479, tokenIndex479
+								if !_rules[ruleIn]() {
+									goto l478
+								}
+								{
+									position481, tokenIndex481 := position, tokenIndex
+									if !_rules[ruleNumber]() {
+										goto l481
+									}
+									goto l482
+								l481:
+									position, tokenIndex = position481, tokenIndex481
+								}
+							l482:
+								if !_rules[ruleWEEKS]() {
+									goto l478
+								}
+								{
+									position483, tokenIndex483 := position, tokenIndex
+									if !_rules[ruleFROM_NOW]() {
+										goto l483
+									}
+									goto l484
+								l483:
+									position, tokenIndex = position483, tokenIndex483
+								}
+							l484:
+							}
+						l479:
+							{
+								add(ruleAction112, position)
+							}
+							goto l475
+						l478:
+							position, tokenIndex = position475, tokenIndex475
+							if !_rules[ruleLAST]() {
+								goto l486
+							}
+							if !_rules[ruleWEEKS]() {
+								goto l486
+							}
+							{
+								add(ruleAction113, position)
+							}
+							goto l475
+						l486:
+							position, tokenIndex = position475, tokenIndex475
+							if !_rules[ruleNEXT]() {
+								goto l488
+							}
+							if !_rules[ruleWEEKS]() {
+								goto l488
+							}
+							{
+								add(ruleAction114, position)
+							}
+							goto l475
+						l488:
+							position, tokenIndex = position475, tokenIndex475
+							if !_rules[ruleLast]() {
+								goto l490
+							}
+							{
+								position491, tokenIndex491 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l491
+								}
+								goto l492
+							l491:
+								position, tokenIndex = position491, tokenIndex491
+							}
+						l492:
+							if !_rules[ruleWEEKS]() {
+								goto l490
+							}
+							{
+								add(ruleAction115, position)
+							}
+							goto l475
+						l490:
+							position, tokenIndex = position475, tokenIndex475
+							if !_rules[ruleNext]() {
+								goto l494
+							}
+							{
+								position495, tokenIndex495 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l495
+								}
+								goto l496
+							l495:
+								position, tokenIndex = position495, tokenIndex495
+							}
+						l496:
+							if !_rules[ruleWEEKS]() {
+								goto l494
+							}
+							{
+								add(ruleAction116, position)
+							}
+							goto l475
+						l494:
+							position, tokenIndex = position475, tokenIndex475
+							if !_rules[ruleTHIS]() {
+								goto l498
+							}
+							if !_rules[ruleWEEKS]() {
+								goto l498
+							}
+							{
+								add(ruleAction117, position)
+							}
+							goto l475
+						l498:
+							position, tokenIndex = position475, tokenIndex475
+							if !_rules[ruleNumber]() {
+								goto l473
+							}
+							if !_rules[ruleWEEKS]() {
+								goto l473
+							}
+							{
+								add(ruleAction118, position)
+							}
+						}
+					l475:
+						add(ruleRelativeWeeks, position474)
+					}
+					goto l225
+				l473:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position502 := position
+						{
+							position503, tokenIndex503 := position, tokenIndex
+							if !_rules[ruleNumber]() {
+								goto l504
+							}
+							if !_rules[ruleMONTHS]() {
+								goto l504
+							}
+							if !_rules[ruleAGO]() {
+								goto l504
+							}
+							{
+								add(ruleAction119, position)
+							}
+							goto l503
+						l504:
+							position, tokenIndex = position503, tokenIndex503
+							{
+								position507, tokenIndex507 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l508
+								}
+								if !_rules[ruleMONTHS]() {
+									goto l508
+								}
+								if !_rules[ruleFROM_NOW]() {
+									goto l508
+								}
+								goto l507
+							l508:
+								position, tokenIndex = position507, tokenIndex507
+								if !_rules[ruleIn]() {
+									goto l506
+								}
+								{
+									position509, tokenIndex509 := position, tokenIndex
+									if !_rules[ruleNumber]() {
+										goto l509
+									}
+									goto l510
+								l509:
+									position, tokenIndex = position509, tokenIndex509
+								}
+							l510:
+								if !_rules[ruleMONTHS]() {
+									goto l506
+								}
+								{
+									position511, tokenIndex511 := position, tokenIndex
+									if !_rules[ruleFROM_NOW]() {
+										goto l511
+									}
+									goto l512
+								l511:
+									position, tokenIndex = position511, tokenIndex511
+								}
+							l512:
+							}
+						l507:
+							{
+								add(ruleAction120, position)
+							}
+							goto l503
+						l506:
+							position, tokenIndex = position503, tokenIndex503
+							if !_rules[ruleLast]() {
+								goto l514
+							}
+							{
+								position515, tokenIndex515 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l515
+								}
+								goto l516
+							l515:
+								position, tokenIndex = position515, tokenIndex515
+							}
+						l516:
+							if !_rules[ruleMONTHS]() {
+								goto l514
+							}
+							{
+								add(ruleAction121, position)
+							}
+							goto l503
+						l514:
+							position, tokenIndex = position503, tokenIndex503
+							if !_rules[ruleNext]() {
+								goto l518
+							}
+							{
+								position519, tokenIndex519 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l519
+								}
+								goto l520
+							l519:
+								position, tokenIndex = position519, tokenIndex519
+							}
+						l520:
+							if !_rules[ruleMONTHS]() {
+								goto l518
+							}
+							{
+								add(ruleAction122, position)
+							}
+							goto l503
+						l518:
+							position, tokenIndex = position503, tokenIndex503
+							if !_rules[ruleTHIS]() {
+								goto l522
+							}
+							if !_rules[ruleMONTHS]() {
+								goto l522
+							}
+							{
+								add(ruleAction123, position)
+							}
+							goto l503
+						l522:
+							position, tokenIndex = position503, tokenIndex503
+							if !_rules[ruleNEXT]() {
+								goto l524
+							}
+							if !_rules[ruleMonth]() {
+								goto l524
+							}
+							{
+								add(ruleAction125, position)
+							}
+							goto l503
+						l524:
+							position, tokenIndex = position503, tokenIndex503
+							{
+								switch buffer[position] {
+								case 't':
+									if !_rules[ruleTHIS]() {
+										goto l501
+									}
+									if !_rules[ruleMonth]() {
+										goto l501
+									}
+									{
+										add(ruleAction126, position)
+									}
+								case 'l', 'p':
+									if !_rules[ruleLAST]() {
+										goto l501
+									}
+									if !_rules[ruleMonth]() {
+										goto l501
+									}
+									{
+										add(ruleAction124, position)
+									}
+								default:
+									if !_rules[ruleMonth]() {
+										goto l501
+									}
+									{
+										add(ruleAction127, position)
+									}
+								}
+							}
+
+						}
+					l503:
+						add(ruleRelativeMonth, position502)
+					}
+					goto l225
+				l501:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position531 := position
+						{
+							position532, tokenIndex532 := position, tokenIndex
+							if !_rules[ruleNumber]() {
+								goto l533
+							}
+							if !_rules[ruleYEARS]() {
+								goto l533
+							}
+							if !_rules[ruleAGO]() {
+								goto l533
+							}
+							{
+								add(ruleAction128, position)
+							}
+							goto l532
+						l533:
+							position, tokenIndex = position532, tokenIndex532
+							{
+								position536, tokenIndex536 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l537
+								}
+								if !_rules[ruleYEARS]() {
+									goto l537
+								}
+								if !_rules[ruleFROM_NOW]() {
+									goto l537
+								}
+								goto l536
+							l537:
+								position, tokenIndex = position536, tokenIndex536
+								if !_rules[ruleIn]() {
+									goto l535
+								}
+								{
+									position538, tokenIndex538 := position, tokenIndex
+									if !_rules[ruleNumber]() {
+										goto l538
+									}
+									goto l539
+								l538:
+									position, tokenIndex = position538, tokenIndex538
+								}
+							l539:
+								if !_rules[ruleYEARS]() {
+									goto l535
+								}
+								{
+									position540, tokenIndex540 := position, tokenIndex
+									if !_rules[ruleFROM_NOW]() {
+										goto l540
+									}
+									goto l541
+								l540:
+									position, tokenIndex = position540, tokenIndex540
+								}
+							l541:
+							}
+						l536:
+							{
+								add(ruleAction129, position)
+							}
+							goto l532
+						l535:
+							position, tokenIndex = position532, tokenIndex532
+							if !_rules[ruleLast]() {
+								goto l543
+							}
+							{
+								position544, tokenIndex544 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l544
+								}
+								goto l545
+							l544:
+								position, tokenIndex = position544, tokenIndex544
+							}
+						l545:
+							if !_rules[ruleYEARS]() {
+								goto l543
+							}
+							{
+								add(ruleAction130, position)
+							}
+							goto l532
+						l543:
+							position, tokenIndex = position532, tokenIndex532
+							if !_rules[ruleNext]() {
+								goto l547
+							}
+							{
+								position548, tokenIndex548 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l548
+								}
+								goto l549
+							l548:
+								position, tokenIndex = position548, tokenIndex548
+							}
+						l549:
+							if !_rules[ruleYEARS]() {
+								goto l547
+							}
+							{
+								add(ruleAction131, position)
+							}
+							goto l532
+						l547:
+							position, tokenIndex = position532, tokenIndex532
+							{
+								switch buffer[position] {
+								case 'n':
+									if !_rules[ruleNEXT]() {
+										goto l530
+									}
+									if !_rules[ruleYEARS]() {
+										goto l530
+									}
+									{
+										add(ruleAction134, position)
+									}
+								case 't':
+									if !_rules[ruleTHIS]() {
+										goto l530
+									}
+									if !_rules[ruleYEARS]() {
+										goto l530
+									}
+									{
+										add(ruleAction132, position)
+									}
+								default:
+									if !_rules[ruleLAST]() {
+										goto l530
+									}
+									if !_rules[ruleYEARS]() {
+										goto l530
+									}
+									{
+										add(ruleAction133, position)
+									}
+								}
+							}
+
+						}
+					l532:
+						add(ruleRelativeYear, position531)
+					}
+					goto l225
+				l530:
+					position, tokenIndex = position225, tokenIndex225
+					{
+						position555 := position
+						{
+							position556, tokenIndex556 := position, tokenIndex
+							if !_rules[ruleNumber]() {
+								goto l557
+							}
+							if !_rules[ruleOrdinal]() {
+								goto l557
+							}
+							goto l556
+						l557:
+							position, tokenIndex = position556, tokenIndex556
+							if !_rules[ruleLast]() {
+								goto l223
+							}
+							{
+								position558, tokenIndex558 := position, tokenIndex
+								if !_rules[ruleNumber]() {
+									goto l558
+								}
+								goto l559
+							l558:
+								position, tokenIndex = position558, tokenIndex558
+							}
+						l559:
+							if !_rules[ruleNumber]() {
+								goto l223
+							}
+						}
+					l556:
+						{
+							add(ruleAction145, position)
+						}
+						add(ruleDate, position555)
+					}
+				}
+			l225:
+				add(ruleMoment, position224)
+			}
+			return true
+		l223:
+			position, tokenIndex = position223, tokenIndex223
+			return false
+		},
+		/* 3 EventDuration <- <(FOR Action0 EventLength (AND? EventLength)*)> */
+		nil,
+		/* 4 EventLength <- <((Number / One) ((&('w') (WEEKS Action4)) | (&('d') (DAYS Action3)) | (&('h') (HOURS Action2)) | (&('m') (MINUTES Action1))))> */
+		func() bool {
+			position562, tokenIndex562 := position, tokenIndex
+			{
+				position563 := position
+				{
+					position564, tokenIndex564 := position, tokenIndex
+					if !_rules[ruleNumber]() {
+						goto l565
+					}
+					goto l564
+				l565:
+					position, tokenIndex = position564, tokenIndex564
+					if !_rules[ruleOne]() {
+						goto l562
+					}
+				}
+			l564:
+				{
+					switch buffer[position] {
+					case 'w':
+						if !_rules[ruleWEEKS]() {
+							goto l562
+						}
+						{
+							add(ruleAction4, position)
+						}
+					case 'd':
+						if !_rules[ruleDAYS]() {
+							goto l562
+						}
+						{
+							add(ruleAction3, position)
+						}
+					case 'h':
+						if !_rules[ruleHOURS]() {
+							goto l562
+						}
+						{
+							add(ruleAction2, position)
+						}
+					default:
+						if !_rules[ruleMINUTES]() {
+							goto l562
+						}
+						{
+							add(ruleAction1, position)
+						}
+					}
+				}
+
+				add(ruleEventLength, position563)
+			}
+			return true
+		l562:
+			position, tokenIndex = position562, tokenIndex562
+			return false
+		},
+		/* 5 EventTimes <- <((FROM? EventStart (('-' _) / TO / UNTIL) EventEnd) / (BETWEEN EventStart AND EventEnd))> */
+		nil,
+		/* 6 EventStart <- <((!Year Clock24Hour !AM !PM Action5) / (!Year Time Action6))> */
+		func() bool {
+			position572, tokenIndex572 := position, tokenIndex
+			{
+				position573 := position
+				{
+					position574, tokenIndex574 := position, tokenIndex
+					{
+						position576, tokenIndex576 := position, tokenIndex
+						if !_rules[ruleYear]() {
+							goto l576
+						}
+						goto l575
+					l576:
+						position, tokenIndex = position576, tokenIndex576
+					}
+					if !_rules[ruleClock24Hour]() {
+						goto l575
+					}
+					{
+						position577, tokenIndex577 := position, tokenIndex
+						if !_rules[ruleAM]() {
+							goto l577
+						}
+						goto l575
+					l577:
+						position, tokenIndex = position577, tokenIndex577
+					}
+					{
+						position578, tokenIndex578 := position, tokenIndex
+						if !_rules[rulePM]() {
+							goto l578
+						}
+						goto l575
+					l578:
+						position, tokenIndex = position578, tokenIndex578
+					}
+					{
+						add(ruleAction5, position)
+					}
+					goto l574
+				l575:
+					position, tokenIndex = position574, tokenIndex574
+					{
+						position580, tokenIndex580 := position, tokenIndex
+						if !_rules[ruleYear]() {
+							goto l580
+						}
+						goto l572
+					l580:
+						position, tokenIndex = position580, tokenIndex580
+					}
+					if !_rules[ruleTime]() {
+						goto l572
+					}
+					{
+						add(ruleAction6, position)
+					}
+				}
+			l574:
+				add(ruleEventStart, position573)
+			}
+			return true
+		l572:
+			position, tokenIndex = position572, tokenIndex572
+			return false
+		},
+		/* 7 EventEnd <- <(Time Action7)> */
+		func() bool {
+			position582, tokenIndex582 := position, tokenIndex
+			{
+				position583 := position
+				if !_rules[ruleTime]() {
+					goto l582
+				}
+				{
+					add(ruleAction7, position)
+				}
+				add(ruleEventEnd, position583)
+			}
+			return true
+		l582:
+			position, tokenIndex = position582, tokenIndex582
+			return false
+		},
+		/* 8 EventTime <- <((AT Time) / (!BareHour Time))> */
+		nil,
+		/* 9 BareHour <- <(Number !':' !AM !PM)> */
+		nil,
+		/* 10 EventWord <- <(<([a-z]+ [0-9] ([a-z] / [0-9])*)> _ Action8)> */
+		nil,
+		/* 11 Text <- <(<(![a-z] !Whitespace .)+> _ Action9)> */
+		nil,
+		/* 12 Recurrence <- <(((EVERY Schedule) / (Frequency (ON Weekdays)?)) Limit* (UNTIL Action10 (UntilMonth / Expr+))?)> */
+		nil,
+		/* 13 UntilMonth <- <(Month (Year ![0-9] _)? &EOF Action11)> */
+		nil,
+		/* 14 Limit <- <((FOR? Number TIMES Action12) / (AT? MinutesPast) / (AT? Time Action13 (And Time Action14)*) / ((FROM / BETWEEN) Time Action15 (TO / AND) Time Action16) / (ON BUSINESS_DAYS Action17) / (ON WEEKEND Action18) / (ON Weekdays) / ((&('i') ('i' 'n' _ Months)) | (&('o') (ON THE? MonthDays)) | (&('b' | 'e') (EXCEPT Exclusion (And Exclusion)*))))> */
+		nil,
+		/* 15 MinutesPast <- <(Number Action19 (And Number Action20)* MINUTES PAST THE? HOURS)> */
+		nil,
+		/* 16 Months <- <(Month Action21 (And Month Action22)*)> */
+		nil,
+		/* 17 Exclusion <- <((ON? WEEKEND Action23) / (ON? Weekday Action24) / (('i' 'n' _)? Month Action25))> */
+		func() bool {
+			position594, tokenIndex594 := position, tokenIndex
+			{
+				position595 := position
+				{
+					position596, tokenIndex596 := position, tokenIndex
+					{
+						position598, tokenIndex598 := position, tokenIndex
+						if !_rules[ruleON]() {
+							goto l598
+						}
+						goto l599
+					l598:
+						position, tokenIndex = position598, tokenIndex598
+					}
+				l599:
+					if !_rules[ruleWEEKEND]() {
+						goto l597
+					}
+					{
+						add(ruleAction23, position)
+					}
+					goto l596
+				l597:
+					position, tokenIndex = position596, tokenIndex596
+					{
+						position602, tokenIndex602 := position, tokenIndex
+						if !_rules[ruleON]() {
+							goto l602
+						}
+						goto l603
+					l602:
+						position, tokenIndex = position602, tokenIndex602
+					}
+				l603:
+					if !_rules[ruleWeekday]() {
+						goto l601
+					}
+					{
+						add(ruleAction24, position)
+					}
+					goto l596
+				l601:
+					position, tokenIndex = position596, tokenIndex596
+					{
+						position605, tokenIndex605 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l605
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l605
+						}
+						position++
+						if !_rules[rule_]() {
+							goto l605
+						}
+						goto l606
+					l605:
+						position, tokenIndex = position605, tokenIndex605
+					}
+				l606:
+					if !_rules[ruleMonth]() {
+						goto l594
+					}
+					{
+						add(ruleAction25, position)
+					}
+				}
+			l596:
+				add(ruleExclusion, position595)
+			}
+			return true
+		l594:
+			position, tokenIndex = position594, tokenIndex594
+			return false
+		},
+		/* 18 Schedule <- <((BUSINESS_DAYS Action26) / (WEEKEND Action27) / (Weekdays Action28) / (Interval? RecurrenceUnit (ON (Weekdays / (THE? MonthDays)))?))> */
+		nil,
+		/* 19 Interval <- <((OTHER Action29) / (Number Action30))> */
+		nil,
+		/* 20 RecurrenceUnit <- <((MINUTES Action31) / ((&('y') (YEARS Action36)) | (&('m') (MONTHS Action35)) | (&('w') (WEEKS Action34)) | (&('d') (DAYS Action33)) | (&('h') (HOURS Action32))))> */
+		nil,
+		/* 21 Frequency <- <((&('m') ('m' 'o' 'n' 't' 'h' 'l' 'y' _ Action40)) | (&('w') ('w' 'e' 'e' 'k' 'l' 'y' _ Action39)) | (&('d') ('d' 'a' 'i' 'l' 'y' _ Action38)) | (&('h') ('h' 'o' 'u' 'r' 'l' 'y' _ Action37)) | (&('a' | 'y') ((('y' 'e' 'a' 'r' 'l' 'y') / ('a' 'n' 'n' 'u' 'a' 'l' 'l' 'y')) _ Action41)))> */
+		nil,
+		/* 22 Weekdays <- <(Weekday Action42 (And Weekday Action43)*)> */
+		func() bool {
+			position612, tokenIndex612 := position, tokenIndex
+			{
+				position613 := position
+				if !_rules[ruleWeekday]() {
+					goto l612
+				}
+				{
+					add(ruleAction42, position)
+				}
+			l615:
+				{
+					position616, tokenIndex616 := position, tokenIndex
+					if !_rules[ruleAnd]() {
+						goto l616
+					}
+					if !_rules[ruleWeekday]() {
+						goto l616
+					}
+					{
+						add(ruleAction43, position)
+					}
+					goto l615
+				l616:
+					position, tokenIndex = position616, tokenIndex616
+				}
+				add(ruleWeekdays, position613)
+			}
+			return true
+		l612:
+			position, tokenIndex = position612, tokenIndex612
+			return false
+		},
+		/* 23 MonthDays <- <(MonthDay (And THE? MonthDay)*)> */
+		func() bool {
+			position618, tokenIndex618 := position, tokenIndex
+			{
+				position619 := position
+				if !_rules[ruleMonthDay]() {
+					goto l618
+				}
+			l620:
+				{
+					position621, tokenIndex621 := position, tokenIndex
+					if !_rules[ruleAnd]() {
+						goto l621
+					}
+					{
+						position622, tokenIndex622 := position, tokenIndex
+						if !_rules[ruleTHE]() {
+							goto l622
+						}
+						goto l623
+					l622:
+						position, tokenIndex = position622, tokenIndex622
+					}
+				l623:
+					if !_rules[ruleMonthDay]() {
+						goto l621
+					}
+					goto l620
+				l621:
+					position, tokenIndex = position621, tokenIndex621
+				}
+				add(ruleMonthDays, position619)
+			}
+			return true
+		l618:
+			position, tokenIndex = position618, tokenIndex618
+			return false
+		},
+		/* 24 MonthDay <- <(Number Ordinal Action44)> */
+		func() bool {
+			position624, tokenIndex624 := position, tokenIndex
+			{
+				position625 := position
+				if !_rules[ruleNumber]() {
+					goto l624
+				}
+				if !_rules[ruleOrdinal]() {
+					goto l624
+				}
+				{
+					add(ruleAction44, position)
+				}
+				add(ruleMonthDay, position625)
+			}
+			return true
+		l624:
+			position, tokenIndex = position624, tokenIndex624
+			return false
+		},
+		/* 25 And <- <((',' _ AND?) / AND)> */
+		func() bool {
+			position627, tokenIndex627 := position, tokenIndex
+			{
+				position628 := position
+				{
+					position629, tokenIndex629 := position, tokenIndex
+					if buffer[position] != rune(',') {
+						goto l630
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l630
+					}
+					{
+						position631, tokenIndex631 := position, tokenIndex
+						if !_rules[ruleAND]() {
+							goto l631
+						}
+						goto l632
+					l631:
+						position, tokenIndex = position631, tokenIndex631
+					}
+				l632:
+					goto l629
+				l630:
+					position, tokenIndex = position629, tokenIndex629
+					if !_rules[ruleAND]() {
+						goto l627
+					}
+				}
+			l629:
+				add(ruleAnd, position628)
+			}
+			return true
+		l627:
+			position, tokenIndex = position627, tokenIndex627
+			return false
+		},
+		/* 26 Boundary <- <((END Period Action46) / ((&('c') (COB Action48)) | (&('e') (EOD Action47)) | (&('b' | 's') (START Period Action45))))> */
+		nil,
+		/* 27 Period <- <(((THE / THIS)? Unit) / (TODAY Action51) / ((&('t') (TOMORROW Action53)) | (&('y') (YESTERDAY Action52)) | (&('n') (NEXT Unit Action50)) | (&('l' | 'p') (LAST Unit Action49))))> */
+		func() bool {
+			position634, tokenIndex634 := position, tokenIndex
+			{
+				position635 := position
+				{
+					position636, tokenIndex636 := position, tokenIndex
+					{
+						position638, tokenIndex638 := position, tokenIndex
+						{
+							position640, tokenIndex640 := position, tokenIndex
+							if !_rules[ruleTHE]() {
+								goto l641
+							}
+							goto l640
+						l641:
+							position, tokenIndex = position640, tokenIndex640
+							if !_rules[ruleTHIS]() {
+								goto l638
+							}
+						}
+					l640:
+						goto l639
+					l638:
+						position, tokenIndex = position638, tokenIndex638
+					}
+				l639:
+					if !_rules[ruleUnit]() {
+						goto l637
+					}
+					goto l636
+				l637:
+					position, tokenIndex = position636, tokenIndex636
+					if !_rules[ruleTODAY]() {
+						goto l642
+					}
+					{
+						add(ruleAction51, position)
+					}
+					goto l636
+				l642:
+					position, tokenIndex = position636, tokenIndex636
+					{
+						switch buffer[position] {
+						case 't':
+							if !_rules[ruleTOMORROW]() {
+								goto l634
+							}
+							{
+								add(ruleAction53, position)
+							}
+						case 'y':
+							if !_rules[ruleYESTERDAY]() {
+								goto l634
+							}
+							{
+								add(ruleAction52, position)
+							}
+						case 'n':
+							if !_rules[ruleNEXT]() {
+								goto l634
+							}
+							if !_rules[ruleUnit]() {
+								goto l634
+							}
+							{
+								add(ruleAction50, position)
+							}
+						default:
+							if !_rules[ruleLAST]() {
+								goto l634
+							}
+							if !_rules[ruleUnit]() {
+								goto l634
+							}
+							{
+								add(ruleAction49, position)
+							}
+						}
+					}
+
+				}
+			l636:
+				add(rulePeriod, position635)
+			}
+			return true
+		l634:
+			position, tokenIndex = position634, tokenIndex634
+			return false
+		},
+		/* 28 Unit <- <((MINUTES Action54) / (BUSINESS_DAYS Action56) / ((&('y') (YEARS Action61)) | (&('q') (QUARTERS Action60)) | (&('m') (MONTHS Action59)) | (&('w') (WEEKS Action58)) | (&('d') (DAYS Action57)) | (&('h') (HOURS Action55))))> */
+		func() bool {
+			position649, tokenIndex649 := position, tokenIndex
+			{
+				position650 := position
+				{
+					position651, tokenIndex651 := position, tokenIndex
+					if !_rules[ruleMINUTES]() {
+						goto l652
+					}
+					{
+						add(ruleAction54, position)
+					}
+					goto l651
+				l652:
+					position, tokenIndex = position651, tokenIndex651
+					if !_rules[ruleBUSINESS_DAYS]() {
+						goto l654
+					}
+					{
+						add(ruleAction56, position)
+					}
+					goto l651
+				l654:
+					position, tokenIndex = position651, tokenIndex651
+					{
+						switch buffer[position] {
+						case 'y':
+							if !_rules[ruleYEARS]() {
+								goto l649
+							}
+							{
+								add(ruleAction61, position)
+							}
+						case 'q':
+							{
+								position658 := position
+								if buffer[position] != rune('q') {
+									goto l649
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l649
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l649
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l649
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l649
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l649
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l649
+								}
+								position++
+								{
+									position659, tokenIndex659 := position, tokenIndex
+									if buffer[position] != rune('s') {
+										goto l659
+									}
+									position++
+									goto l660
+								l659:
+									position, tokenIndex = position659, tokenIndex659
+								}
+							l660:
+								if !_rules[rule_]() {
+									goto l649
+								}
+								add(ruleQUARTERS, position658)
+							}
+							{
+								add(ruleAction60, position)
+							}
+						case 'm':
+							if !_rules[ruleMONTHS]() {
+								goto l649
+							}
+							{
+								add(ruleAction59, position)
+							}
+						case 'w':
+							if !_rules[ruleWEEKS]() {
+								goto l649
+							}
+							{
+								add(ruleAction58, position)
+							}
+						case 'd':
+							if !_rules[ruleDAYS]() {
+								goto l649
+							}
+							{
+								add(ruleAction57, position)
+							}
+						default:
+							if !_rules[ruleHOURS]() {
+								goto l649
+							}
+							{
+								add(ruleAction55, position)
+							}
+						}
+					}
+
+				}
+			l651:
+				add(ruleUnit, position650)
+			}
+			return true
+		l649:
+			position, tokenIndex = position649, tokenIndex649
+			return false
+		},
+		/* 29 Holiday <- <((LAST HolidayName Action62) / (NEXT HolidayName Action63) / (THIS HolidayName Action64) / (HolidayName Year ![0-9] _ Action65) / (HolidayName Action66))> */
+		nil,
+		/* 30 Week <- <((Year '-'? 'w' WeekNumber '-'? <[1-7]> ![0-9] _ Action67) / (Year '-'? 'w' WeekNumber ![0-9] _ Action68) / ((('w' 'e' 'e' 'k' _) / 'w') WeekNumber ![0-9] _ OF? Year ![0-9] _ Action69) / ((('w' 'e' 'e' 'k' _) / 'w') WeekNumber ![0-9] _ Action70) / ('w' 'e' 'e' 'k' _ OF Action71))> */
+		nil,
+		/* 31 NthWeekday <- <(Nth Weekday (OF / ('i' 'n' _)) Action72)> */
+		nil,
+		/* 32 NthDay <- <((Nth BUSINESS_DAYS OF Action73) / (Nth DAYS OF Action74))> */
+		nil,
+		/* 33 RelativeMinutes <- <((Number MINUTES AGO Action75) / (((Number MINUTES FROM_NOW) / (In Number? MINUTES FROM_NOW?)) Action76) / (Last Number? MINUTES Action77) / (Next Number? MINUTES Action78) / (THIS MINUTES Action79) / (Number MINUTES Action80))> */
+		nil,
+		/* 34 RelativeHours <- <((Number HOURS AGO Action81) / (((Number HOURS FROM_NOW) / (In Number? HOURS FROM_NOW?)) Action82) / (Last Number? HOURS Action83) / (Next Number? HOURS Action84) / (THIS HOURS Action85) / (Number HOURS Action86))> */
+		nil,
+		/* 35 RelativeDays <- <((Number DAYS AGO Action87) / (((Number DAYS FROM_NOW) / (In Number? DAYS FROM_NOW?)) Action88) / (Last Number? DAYS Action89) / (Next Number? DAYS Action90) / (THIS DAYS Action91) / (Number DAYS Action92))> */
+		nil,
+		/* 36 Offset <- <(((Number / One) Unit AFTER Action93) / ((Number / One) Unit BEFORE Action94))> */
+		nil,
+		/* 37 WeekdayInWeek <- <((Weekday RelativeWeek Action95) / (RelativeWeek ON? Weekday Action96) / (Weekday AFTER NEXT Action97))> */
+		nil,
+		/* 38 RelativeWeek <- <((&('t') (THIS WEEKS)) | (&('n') (NEXT WEEKS Action99)) | (&('l' | 'p') (LAST WEEKS Action98)))> */
+		func() bool {
+			position675, tokenIndex675 := position, tokenIndex
+			{
+				position676 := position
+				{
+					switch buffer[position] {
+					case 't':
+						if !_rules[ruleTHIS]() {
+							goto l675
+						}
+						if !_rules[ruleWEEKS]() {
+							goto l675
+						}
+					case 'n':
+						if !_rules[ruleNEXT]() {
+							goto l675
+						}
+						if !_rules[ruleWEEKS]() {
+							goto l675
+						}
+						{
+							add(ruleAction99, position)
+						}
+					default:
+						if !_rules[ruleLAST]() {
+							goto l675
+						}
+						if !_rules[ruleWEEKS]() {
+							goto l675
+						}
+						{
+							add(ruleAction98, position)
+						}
+					}
+				}
+
+				add(ruleRelativeWeek, position676)
+			}
+			return true
+		l675:
+			position, tokenIndex = position675, tokenIndex675
+			return false
+		},
+		/* 39 RelativeBusinessDays <- <((Number BUSINESS_DAYS AGO Action100) / (((Number BUSINESS_DAYS FROM_NOW) / (In Number? BUSINESS_DAYS FROM_NOW?)) Action101) / (Last Number? BUSINESS_DAYS Action102) / (Next Number? BUSINESS_DAYS Action103) / (Number BUSINESS_DAYS Action104))> */
+		nil,
+		/* 40 RelativeBusinessHours <- <((Number BUSINESS_MINUTES AGO Action105) / (((Number BUSINESS_MINUTES FROM_NOW) / (In Number? BUSINESS_MINUTES FROM_NOW?)) Action106) / (Number BUSINESS_MINUTES Action107) / (Number BUSINESS_HOURS AGO Action108) / (((Number BUSINESS_HOURS FROM_NOW) / (In Number? BUSINESS_HOURS FROM_NOW?)) Action109) / (Number BUSINESS_HOURS Action110))> */
+		nil,
+		/* 41 RelativeWeeks <- <((Number WEEKS AGO Action111) / (((Number WEEKS FROM_NOW) / (In Number? WEEKS FROM_NOW?)) Action112) / (LAST WEEKS Action113) / (NEXT WEEKS Action114) / (Last Number? WEEKS Action115) / (Next Number? WEEKS Action116) / (THIS WEEKS Action117) / (Number WEEKS Action118))> */
+		nil,
+		/* 42 RelativeMonth <- <((Number MONTHS AGO Action119) / (((Number MONTHS FROM_NOW) / (In Number? MONTHS FROM_NOW?)) Action120) / (Last Number? MONTHS Action121) / (Next Number? MONTHS Action122) / (THIS MONTHS Action123) / (NEXT Month Action125) / ((&('t') (THIS Month Action126)) | (&('l' | 'p') (LAST Month Action124)) | (&('a' | 'd' | 'f' | 'j' | 'm' | 'n' | 'o' | 's') (Month Action127))))> */
+		nil,
+		/* 43 RelativeYear <- <((Number YEARS AGO Action128) / (((Number YEARS FROM_NOW) / (In Number? YEARS FROM_NOW?)) Action129) / (Last Number? YEARS Action130) / (Next Number? YEARS Action131) / ((&('n') (NEXT YEARS Action134)) | (&('t') (THIS YEARS Action132)) | (&('l' | 'p') (LAST YEARS Action133))))> */
+		nil,
+		/* 44 RelativeWeekdays <- <((TODAY Action135) / (TOMORROW Action137) / (Number Weekday AGO Action138) / (((Number Weekday FROM_NOW) / (In Number Weekday FROM_NOW?)) Action139) / (Number &PluralWeekday Weekday Action140) / (THIS Weekday Action143) / ((&('n') (NEXT Weekday Action142)) | (&('y') (YESTERDAY Action136)) | (&('l' | 'p') (LAST Weekday Action141)) | (&('f' | 'm' | 's' | 't' | 'w') (Weekday Action144))))> */
+		nil,
+		/* 45 Date <- <(((Number Ordinal) / (Last Number? Number)) Action145)> */
+		nil,
+		/* 46 Time <- <((NOON Action146) / (MIDNIGHT Action147) / Clock12Hour / Clock24Hour)> */
+		func() bool {
+			position687, tokenIndex687 := position, tokenIndex
+			{
+				position688 := position
+				{
+					position689, tokenIndex689 := position, tokenIndex
+					{
+						position691 := position
+						{
+							position692, tokenIndex692 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l693
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l693
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l693
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l693
+							}
+							position++
+							goto l692
+						l693:
+							position, tokenIndex = position692, tokenIndex692
+							if buffer[position] != rune('m') {
+								goto l690
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l690
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l690
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l690
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l690
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l690
+							}
+							position++
+						}
+					l692:
+						if !_rules[rule_]() {
+							goto l690
+						}
+						add(ruleNOON, position691)
+					}
+					{
+						add(ruleAction146, position)
+					}
+					goto l689
+				l690:
+					position, tokenIndex = position689, tokenIndex689
+					{
+						position696 := position
+						if buffer[position] != rune('m') {
+							goto l695
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l695
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l695
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l695
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l695
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l695
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l695
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l695
+						}
+						position++
+						if !_rules[rule_]() {
+							goto l695
+						}
+						add(ruleMIDNIGHT, position696)
+					}
+					{
+						add(ruleAction147, position)
+					}
+					goto l689
+				l695:
+					position, tokenIndex = position689, tokenIndex689
+					{
+						position699 := position
+						{
+							position700, tokenIndex700 := position, tokenIndex
+							if !_rules[ruleNumber]() {
+								goto l701
+							}
+							{
+								add(ruleAction148, position)
+							}
+							{
+								position703, tokenIndex703 := position, tokenIndex
+								if !_rules[ruleMinutes]() {
+									goto l703
+								}
+								{
+									position705, tokenIndex705 := position, tokenIndex
+									if !_rules[ruleSeconds]() {
+										goto l705
+									}
+									goto l706
+								l705:
+									position, tokenIndex = position705, tokenIndex705
+								}
+							l706:
+								goto l704
+							l703:
+								position, tokenIndex = position703, tokenIndex703
+							}
+						l704:
+							if !_rules[ruleAM]() {
+								goto l701
+							}
+							goto l700
+						l701:
+							position, tokenIndex = position700, tokenIndex700
+							if !_rules[ruleNumber]() {
+								goto l698
+							}
+							{
+								add(ruleAction149, position)
+							}
+							{
+								position708, tokenIndex708 := position, tokenIndex
+								if !_rules[ruleMinutes]() {
+									goto l708
+								}
+								{
+									position710, tokenIndex710 := position, tokenIndex
+									if !_rules[ruleSeconds]() {
+										goto l710
+									}
+									goto l711
+								l710:
+									position, tokenIndex = position710, tokenIndex710
+								}
+							l711:
+								goto l709
+							l708:
+								position, tokenIndex = position708, tokenIndex708
+							}
+						l709:
+							if !_rules[rulePM]() {
+								goto l698
+							}
+						}
+					l700:
+						add(ruleClock12Hour, position699)
+					}
+					goto l689
+				l698:
+					position, tokenIndex = position689, tokenIndex689
+					if !_rules[ruleClock24Hour]() {
+						goto l687
+					}
+				}
+			l689:
+				add(ruleTime, position688)
+			}
+			return true
+		l687:
+			position, tokenIndex = position687, tokenIndex687
+			return false
+		},
+		/* 47 Clock12Hour <- <((Number Action148 (Minutes Seconds?)? AM) / (Number Action149 (Minutes Seconds?)? PM))> */
+		nil,
+		/* 48 Clock24Hour <- <(Number Action150 (Minutes Seconds?)?)> */
+		func() bool {
+			position713, tokenIndex713 := position, tokenIndex
+			{
+				position714 := position
+				if !_rules[ruleNumber]() {
+					goto l713
+				}
+				{
+					add(ruleAction150, position)
+				}
+				{
+					position716, tokenIndex716 := position, tokenIndex
+					if !_rules[ruleMinutes]() {
+						goto l716
+					}
+					{
+						position718, tokenIndex718 := position, tokenIndex
+						if !_rules[ruleSeconds]() {
+							goto l718
+						}
+						goto l719
+					l718:
+						position, tokenIndex = position718, tokenIndex718
+					}
+				l719:
+					goto l717
+				l716:
+					position, tokenIndex = position716, tokenIndex716
+				}
+			l717:
+				add(ruleClock24Hour, position714)
+			}
+			return true
+		l713:
+			position, tokenIndex = position713, tokenIndex713
+			return false
+		},
+		/* 49 Minutes <- <(':' Number Action151)> */
+		func() bool {
+			position720, tokenIndex720 := position, tokenIndex
+			{
+				position721 := position
+				if buffer[position] != rune(':') {
+					goto l720
+				}
+				position++
+				if !_rules[ruleNumber]() {
+					goto l720
+				}
+				{
+					add(ruleAction151, position)
+				}
+				add(ruleMinutes, position721)
+			}
+			return true
+		l720:
+			position, tokenIndex = position720, tokenIndex720
+			return false
+		},
+		/* 50 Seconds <- <(':' Number Action152)> */
+		func() bool {
+			position723, tokenIndex723 := position, tokenIndex
+			{
+				position724 := position
+				if buffer[position] != rune(':') {
+					goto l723
+				}
+				position++
+				if !_rules[ruleNumber]() {
+					goto l723
+				}
+				{
+					add(ruleAction152, position)
+				}
+				add(ruleSeconds, position724)
+			}
+			return true
+		l723:
+			position, tokenIndex = position723, tokenIndex723
+			return false
+		},
+		/* 51 Number <- <(('t' 'w' 'o' _ Action155) / ('t' 'h' 'r' 'e' 'e' _ Action156) / ('f' 'o' 'u' 'r' _ Action157) / ('s' 'i' 'x' _ Action159) / ((&('t') ('t' 'e' 'n' _ Action163)) | (&('n') ('n' 'i' 'n' 'e' _ Action162)) | (&('e') ('e' 'i' 'g' 'h' 't' _ Action161)) | (&('s') ('s' 'e' 'v' 'e' 'n' _ Action160)) | (&('f') ('f' 'i' 'v' 'e' _ Action158)) | (&('o') ('o' 'n' 'e' _ Action154)) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') (<[0-9]+> _ Action153))))> */
+		func() bool {
+			position726, tokenIndex726 := position, tokenIndex
+			{
+				position727 := position
+				{
+					position728, tokenIndex728 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l729
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l729
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l729
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l729
+					}
+					{
+						add(ruleAction155, position)
+					}
+					goto l728
+				l729:
+					position, tokenIndex = position728, tokenIndex728
+					if buffer[position] != rune('t') {
+						goto l731
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l731
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l731
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l731
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l731
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l731
+					}
+					{
+						add(ruleAction156, position)
+					}
+					goto l728
+				l731:
+					position, tokenIndex = position728, tokenIndex728
+					if buffer[position] != rune('f') {
+						goto l733
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l733
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l733
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l733
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l733
+					}
+					{
+						add(ruleAction157, position)
+					}
+					goto l728
+				l733:
+					position, tokenIndex = position728, tokenIndex728
+					if buffer[position] != rune('s') {
+						goto l735
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l735
+					}
+					position++
+					if buffer[position] != rune('x') {
+						goto l735
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l735
+					}
+					{
+						add(ruleAction159, position)
+					}
+					goto l728
+				l735:
+					position, tokenIndex = position728, tokenIndex728
+					{
+						switch buffer[position] {
+						case 't':
+							if buffer[position] != rune('t') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l726
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l726
+							}
+							{
+								add(ruleAction163, position)
+							}
+						case 'n':
+							if buffer[position] != rune('n') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l726
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l726
+							}
+							{
+								add(ruleAction162, position)
+							}
+						case 'e':
+							if buffer[position] != rune('e') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l726
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l726
+							}
+							{
+								add(ruleAction161, position)
+							}
+						case 's':
+							if buffer[position] != rune('s') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('v') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l726
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l726
+							}
+							{
+								add(ruleAction160, position)
+							}
+						case 'f':
+							if buffer[position] != rune('f') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('v') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l726
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l726
+							}
+							{
+								add(ruleAction158, position)
+							}
+						case 'o':
+							if buffer[position] != rune('o') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l726
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l726
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l726
+							}
+							{
+								add(ruleAction154, position)
+							}
+						default:
+							{
+								position744 := position
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l726
+								}
+								position++
+							l745:
+								{
+									position746, tokenIndex746 := position, tokenIndex
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l746
+									}
+									position++
+									goto l745
+								l746:
+									position, tokenIndex = position746, tokenIndex746
+								}
+								add(rulePegText, position744)
+							}
+							if !_rules[rule_]() {
+								goto l726
+							}
+							{
+								add(ruleAction153, position)
+							}
+						}
+					}
+
+				}
+			l728:
+				add(ruleNumber, position727)
+			}
+			return true
+		l726:
+			position, tokenIndex = position726, tokenIndex726
+			return false
+		},
+		/* 52 Year <- <(<([0-9] [0-9] [0-9] [0-9])> Action164)> */
+		func() bool {
+			position748, tokenIndex748 := position, tokenIndex
+			{
+				position749 := position
+				{
+					position750 := position
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l748
+					}
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l748
+					}
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l748
+					}
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l748
+					}
+					position++
+					add(rulePegText, position750)
+				}
+				{
+					add(ruleAction164, position)
+				}
+				add(ruleYear, position749)
+			}
+			return true
+		l748:
+			position, tokenIndex = position748, tokenIndex748
+			return false
+		},
+		/* 53 WeekNumber <- <(<([0-9] [0-9]?)> Action165)> */
+		func() bool {
+			position752, tokenIndex752 := position, tokenIndex
+			{
+				position753 := position
+				{
+					position754 := position
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l752
+					}
+					position++
+					{
+						position755, tokenIndex755 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l755
+						}
+						position++
+						goto l756
+					l755:
+						position, tokenIndex = position755, tokenIndex755
+					}
+				l756:
+					add(rulePegText, position754)
+				}
+				{
+					add(ruleAction165, position)
+				}
+				add(ruleWeekNumber, position753)
+			}
+			return true
+		l752:
+			position, tokenIndex = position752, tokenIndex752
+			return false
+		},
+		/* 54 HolidayName <- <(('n' 'e' 'w' ' ' 'y' 'e' 'a' 'r' S? (' ' 'e' 'v' 'e') ![a-z] _ Action166) / ((('m' 'a' 'r' 't' 'i' 'n' ' ' 'l' 'u' 't' 'h' 'e' 'r' ' ' 'k' 'i' 'n' 'g' (' ' 'j' 'r' '.')?) / ('m' 'l' 'k')) (' ' 'd' 'a' 'y') ![a-z] _ Action168) / ('v' 'a' 'l' 'e' 'n' 't' 'i' 'n' 'e' S? (' ' 'd' 'a' 'y') ![a-z] _ Action169) / ('p' 'r' 'e' 's' 'i' 'd' 'e' 'n' 't' S? (' ' 'd' 'a' 'y') ![a-z] _ Action170) / ((('s' 't' '.' ' ') / ('s' 't' ' ') / ('s' 'a' 'i' 'n' 't' ' ')) ('p' 'a' 't' 'r' 'i' 'c' 'k') S? (' ' 'd' 'a' 'y') ![a-z] _ Action171) / ('g' 'o' 'o' 'd' ' ' 'f' 'r' 'i' 'd' 'a' 'y' ![a-z] _ Action172) / ('e' 'a' 's' 't' 'e' 'r' ' ' 'm' 'o' 'n' 'd' 'a' 'y' ![a-z] _ Action173) / ('e' 'a' 's' 't' 'e' 'r' (' ' 's' 'u' 'n' 'd' 'a' 'y')? ![a-z] _ Action174) / ('m' 'o' 't' 'h' 'e' 'r' S? (' ' 'd' 'a' 'y') ![a-z] _ Action175) / ('m' 'e' 'm' 'o' 'r' 'i' 'a' 'l' ' ' 'd' 'a' 'y' ![a-z] _ Action176) / ('f' 'a' 't' 'h' 'e' 'r' S? (' ' 'd' 'a' 'y') ![a-z] _ Action177) / ('s' 'p' 'r' 'i' 'n' 'g' ' ' 'b' 'a' 'n' 'k' ' ' 'h' 'o' 'l' 'i' 'd' 'a' 'y' ![a-z] _ Action181) / ('c' 'o' 'l' 'u' 'm' 'b' 'u' 's' ' ' 'd' 'a' 'y' ![a-z] _ Action187) / ((('c' 'h' 'r' 'i' 's' 't' 'm' 'a' 's') / ('x' 'm' 'a' 's')) (' ' 'e' 'v' 'e') ![a-z] _ Action191) / ((&('b') ('b' 'o' 'x' 'i' 'n' 'g' ' ' 'd' 'a' 'y' ![a-z] _ Action193)) | (&('c' | 'x') ((('c' 'h' 'r' 'i' 's' 't' 'm' 'a' 's') / ('x' 'm' 'a' 's')) (' ' 'd' 'a' 'y')? ![a-z] _ Action192)) | (&('t') ('t' 'h' 'a' 'n' 'k' 's' 'g' 'i' 'v' 'i' 'n' 'g' (' ' 'd' 'a' 'y')? ![a-z] _ Action190)) | (&('v') ('v' 'e' 't' 'e' 'r' 'a' 'n' S? (' ' 'd' 'a' 'y') ![a-z] _ Action189)) | (&('h') ('h' 'a' 'l' 'l' 'o' 'w' 'e' 'e' 'n' ![a-z] _ Action188)) | (&('d' | 'g') ((('g' 'e' 'r' 'm' 'a' 'n' ' ' 'u' 'n' 'i' 't' 'y' ' ' 'd' 'a' 'y') / ('d' 'a' 'y' ' ' 'o' 'f' ' ' 'g' 'e' 'r' 'm' 'a' 'n' ' ' 'u' 'n' 'i' 't' 'y')) ![a-z] _ Action186)) | (&('p' | 'w') ((('w' 'h' 'i' 't') / ('p' 'e' 'n' 't' 'e' 'c' 'o' 's' 't')) (' ' 'm' 'o' 'n' 'd' 'a' 'y') ![a-z] _ Action185)) | (&('a') ('a' 's' 'c' 'e' 'n' 's' 'i' 'o' 'n' ' ' 'd' 'a' 'y' ![a-z] _ Action184)) | (&('m') ('m' 'a' 'y' ' ' 'd' 'a' 'y' ![a-z] _ Action183)) | (&('s') ('s' 'u' 'm' 'm' 'e' 'r' ' ' 'b' 'a' 'n' 'k' ' ' 'h' 'o' 'l' 'i' 'd' 'a' 'y' ![a-z] _ Action182)) | (&('e') ('e' 'a' 'r' 'l' 'y' ' ' 'm' 'a' 'y' ' ' 'b' 'a' 'n' 'k' ' ' 'h' 'o' 'l' 'i' 'd' 'a' 'y' ![a-z] _ Action180)) | (&('l') ('l' 'a' 'b' 'o' 'u'? ('r' ' ' 'd' 'a' 'y') ![a-z] _ Action179)) | (&('n') ('n' 'e' 'w' ' ' 'y' 'e' 'a' 'r' S? (' ' 'd' 'a' 'y')? ![a-z] _ Action167)) | (&('4' | 'f' | 'i') ((('i' 'n' 'd' 'e' 'p' 'e' 'n' 'd' 'e' 'n' 'c' 'e' ' ' 'd' 'a' 'y') / ((('f' 'o' 'u' 'r' 't' 'h') / ('4' 't' 'h')) (' ' 'o' 'f' ' ' 'j' 'u' 'l' 'y'))) ![a-z] _ Action178))))> */
+		func() bool {
+			position758, tokenIndex758 := position, tokenIndex
+			{
+				position759 := position
+				{
+					position760, tokenIndex760 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l761
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l761
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l761
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l761
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l761
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l761
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l761
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l761
+					}
+					position++
+					{
+						position762, tokenIndex762 := position, tokenIndex
+						if !_rules[ruleS]() {
+							goto l762
+						}
+						goto l763
+					l762:
+						position, tokenIndex = position762, tokenIndex762
+					}
+				l763:
+					if buffer[position] != rune(' ') {
+						goto l761
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l761
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l761
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l761
+					}
+					position++
+					{
+						position764, tokenIndex764 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l764
+						}
+						position++
+						goto l761
+					l764:
+						position, tokenIndex = position764, tokenIndex764
+					}
+					if !_rules[rule_]() {
+						goto l761
+					}
+					{
+						add(ruleAction166, position)
+					}
+					goto l760
+				l761:
+					position, tokenIndex = position760, tokenIndex760
+					{
+						position767, tokenIndex767 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune(' ') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune(' ') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('k') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l768
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l768
+						}
+						position++
+						{
+							position769, tokenIndex769 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l769
+							}
+							position++
+							if buffer[position] != rune('j') {
+								goto l769
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l769
+							}
+							position++
+							if buffer[position] != rune('.') {
+								goto l769
+							}
+							position++
+							goto l770
+						l769:
+							position, tokenIndex = position769, tokenIndex769
+						}
+					l770:
+						goto l767
+					l768:
+						position, tokenIndex = position767, tokenIndex767
+						if buffer[position] != rune('m') {
+							goto l766
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l766
+						}
+						position++
+						if buffer[position] != rune('k') {
+							goto l766
+						}
+						position++
+					}
+				l767:
+					if buffer[position] != rune(' ') {
+						goto l766
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l766
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l766
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l766
+					}
+					position++
+					{
+						position771, tokenIndex771 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l771
+						}
+						position++
+						goto l766
+					l771:
+						position, tokenIndex = position771, tokenIndex771
+					}
+					if !_rules[rule_]() {
+						goto l766
+					}
+					{
+						add(ruleAction168, position)
+					}
+					goto l760
+				l766:
+					position, tokenIndex = position760, tokenIndex760
+					if buffer[position] != rune('v') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l773
+					}
+					position++
+					{
+						position774, tokenIndex774 := position, tokenIndex
+						if !_rules[ruleS]() {
+							goto l774
+						}
+						goto l775
+					l774:
+						position, tokenIndex = position774, tokenIndex774
+					}
+				l775:
+					if buffer[position] != rune(' ') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l773
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l773
+					}
+					position++
+					{
+						position776, tokenIndex776 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l776
+						}
+						position++
+						goto l773
+					l776:
+						position, tokenIndex = position776, tokenIndex776
+					}
+					if !_rules[rule_]() {
+						goto l773
+					}
+					{
+						add(ruleAction169, position)
+					}
+					goto l760
+				l773:
+					position, tokenIndex = position760, tokenIndex760
+					if buffer[position] != rune('p') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l778
+					}
+					position++
+					{
+						position779, tokenIndex779 := position, tokenIndex
+						if !_rules[ruleS]() {
+							goto l779
+						}
+						goto l780
+					l779:
+						position, tokenIndex = position779, tokenIndex779
+					}
+				l780:
+					if buffer[position] != rune(' ') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l778
+					}
+					position++
+					{
+						position781, tokenIndex781 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l781
+						}
+						position++
+						goto l778
+					l781:
+						position, tokenIndex = position781, tokenIndex781
+					}
+					if !_rules[rule_]() {
+						goto l778
+					}
+					{
+						add(ruleAction170, position)
+					}
+					goto l760
+				l778:
+					position, tokenIndex = position760, tokenIndex760
+					{
+						position784, tokenIndex784 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l785
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l785
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l785
+						}
+						position++
+						if buffer[position] != rune(' ') {
+							goto l785
+						}
+						position++
+						goto l784
+					l785:
+						position, tokenIndex = position784, tokenIndex784
+						if buffer[position] != rune('s') {
+							goto l786
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l786
+						}
+						position++
+						if buffer[position] != rune(' ') {
+							goto l786
+						}
+						position++
+						goto l784
+					l786:
+						position, tokenIndex = position784, tokenIndex784
+						if buffer[position] != rune('s') {
+							goto l783
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l783
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l783
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l783
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l783
+						}
+						position++
+						if buffer[position] != rune(' ') {
+							goto l783
+						}
+						position++
+					}
+				l784:
+					if buffer[position] != rune('p') {
+						goto l783
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l783
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l783
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l783
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l783
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l783
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l783
+					}
+					position++
+					{
+						position787, tokenIndex787 := position, tokenIndex
+						if !_rules[ruleS]() {
+							goto l787
+						}
+						goto l788
+					l787:
+						position, tokenIndex = position787, tokenIndex787
+					}
+				l788:
+					if buffer[position] != rune(' ') {
+						goto l783
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l783
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l783
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l783
+					}
+					position++
+					{
+						position789, tokenIndex789 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l789
+						}
+						position++
+						goto l783
+					l789:
+						position, tokenIndex = position789, tokenIndex789
+					}
+					if !_rules[rule_]() {
+						goto l783
+					}
+					{
+						add(ruleAction171, position)
+					}
+					goto l760
+				l783:
+					position, tokenIndex = position760, tokenIndex760
+					if buffer[position] != rune('g') {
+						goto l791
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l791
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l791
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l791
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l791
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l791
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l791
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l791
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l791
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l791
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l791
+					}
+					position++
+					{
+						position792, tokenIndex792 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l792
+						}
+						position++
+						goto l791
+					l792:
+						position, tokenIndex = position792, tokenIndex792
+					}
+					if !_rules[rule_]() {
+						goto l791
+					}
+					{
+						add(ruleAction172, position)
+					}
+					goto l760
+				l791:
+					position, tokenIndex = position760, tokenIndex760
+					if buffer[position] != rune('e') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l794
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l794
+					}
+					position++
+					{
+						position795, tokenIndex795 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l795
+						}
+						position++
+						goto l794
+					l795:
+						position, tokenIndex = position795, tokenIndex795
+					}
+					if !_rules[rule_]() {
+						goto l794
+					}
+					{
+						add(ruleAction173, position)
+					}
+					goto l760
+				l794:
+					position, tokenIndex = position760, tokenIndex760
+					if buffer[position] != rune('e') {
+						goto l797
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l797
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l797
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l797
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l797
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l797
+					}
+					position++
+					{
+						position798, tokenIndex798 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l798
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l798
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l798
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l798
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l798
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l798
+						}
+						position++
+						if buffer[position] != rune('y') {
+							goto l798
+						}
+						position++
+						goto l799
+					l798:
+						position, tokenIndex = position798, tokenIndex798
+					}
+				l799:
+					{
+						position800, tokenIndex800 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l800
+						}
+						position++
+						goto l797
+					l800:
+						position, tokenIndex = position800, tokenIndex800
+					}
+					if !_rules[rule_]() {
+						goto l797
+					}
+					{
+						add(ruleAction174, position)
+					}
+					goto l760
+				l797:
+					position, tokenIndex = position760, tokenIndex760
+					if buffer[position] != rune('m') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l802
+					}
+					position++
+					{
+						position803, tokenIndex803 := position, tokenIndex
+						if !_rules[ruleS]() {
+							goto l803
+						}
+						goto l804
+					l803:
+						position, tokenIndex = position803, tokenIndex803
+					}
+				l804:
+					if buffer[position] != rune(' ') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l802
+					}
+					position++
+					{
+						position805, tokenIndex805 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l805
+						}
+						position++
+						goto l802
+					l805:
+						position, tokenIndex = position805, tokenIndex805
+					}
+					if !_rules[rule_]() {
+						goto l802
+					}
+					{
+						add(ruleAction175, position)
+					}
+					goto l760
+				l802:
+					position, tokenIndex = position760, tokenIndex760
+					if buffer[position] != rune('m') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l807
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l807
+					}
+					position++
+					{
+						position808, tokenIndex808 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l808
+						}
+						position++
+						goto l807
+					l808:
+						position, tokenIndex = position808, tokenIndex808
+					}
+					if !_rules[rule_]() {
+						goto l807
+					}
+					{
+						add(ruleAction176, position)
+					}
+					goto l760
+				l807:
+					position, tokenIndex = position760, tokenIndex760
+					if buffer[position] != rune('f') {
+						goto l810
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l810
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l810
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l810
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l810
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l810
+					}
+					position++
+					{
+						position811, tokenIndex811 := position, tokenIndex
+						if !_rules[ruleS]() {
+							goto l811
+						}
+						goto l812
+					l811:
+						position, tokenIndex = position811, tokenIndex811
+					}
+				l812:
+					if buffer[position] != rune(' ') {
+						goto l810
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l810
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l810
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l810
+					}
+					position++
+					{
+						position813, tokenIndex813 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l813
+						}
+						position++
+						goto l810
+					l813:
+						position, tokenIndex = position813, tokenIndex813
+					}
+					if !_rules[rule_]() {
+						goto l810
+					}
+					{
+						add(ruleAction177, position)
+					}
+					goto l760
+				l810:
+					position, tokenIndex = position760, tokenIndex760
+					if buffer[position] != rune('s') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l815
+					}
+					position++
+					{
+						position816, tokenIndex816 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l816
+						}
+						position++
+						goto l815
+					l816:
+						position, tokenIndex = position816, tokenIndex816
+					}
+					if !_rules[rule_]() {
+						goto l815
+					}
+					{
+						add(ruleAction181, position)
+					}
+					goto l760
+				l815:
+					position, tokenIndex = position760, tokenIndex760
+					if buffer[position] != rune('c') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l818
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l818
+					}
+					position++
+					{
+						position819, tokenIndex819 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l819
+						}
+						position++
+						goto l818
+					l819:
+						position, tokenIndex = position819, tokenIndex819
+					}
+					if !_rules[rule_]() {
+						goto l818
+					}
+					{
+						add(ruleAction187, position)
+					}
+					goto l760
+				l818:
+					position, tokenIndex = position760, tokenIndex760
+					{
+						position822, tokenIndex822 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l823
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l823
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l823
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l823
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l823
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l823
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l823
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l823
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l823
+						}
+						position++
+						goto l822
+					l823:
+						position, tokenIndex = position822, tokenIndex822
+						if buffer[position] != rune('x') {
+							goto l821
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l821
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l821
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l821
+						}
+						position++
+					}
+				l822:
+					if buffer[position] != rune(' ') {
+						goto l821
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l821
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l821
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l821
+					}
+					position++
+					{
+						position824, tokenIndex824 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l824
+						}
+						position++
+						goto l821
+					l824:
+						position, tokenIndex = position824, tokenIndex824
+					}
+					if !_rules[rule_]() {
+						goto l821
+					}
+					{
+						add(ruleAction191, position)
+					}
+					goto l760
+				l821:
+					position, tokenIndex = position760, tokenIndex760
+					{
+						switch buffer[position] {
+						case 'b':
+							if buffer[position] != rune('b') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('x') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							{
+								position827, tokenIndex827 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l827
+								}
+								position++
+								goto l758
+							l827:
+								position, tokenIndex = position827, tokenIndex827
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction193, position)
+							}
+						case 'c', 'x':
+							{
+								position829, tokenIndex829 := position, tokenIndex
+								if buffer[position] != rune('c') {
+									goto l830
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l830
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l830
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l830
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l830
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l830
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l830
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l830
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l830
+								}
+								position++
+								goto l829
+							l830:
+								position, tokenIndex = position829, tokenIndex829
+								if buffer[position] != rune('x') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l758
+								}
+								position++
+							}
+						l829:
+							{
+								position831, tokenIndex831 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l831
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l831
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l831
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l831
+								}
+								position++
+								goto l832
+							l831:
+								position, tokenIndex = position831, tokenIndex831
+							}
+						l832:
+							{
+								position833, tokenIndex833 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l833
+								}
+								position++
+								goto l758
+							l833:
+								position, tokenIndex = position833, tokenIndex833
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction192, position)
+							}
+						case 't':
+							if buffer[position] != rune('t') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('k') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('v') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l758
+							}
+							position++
+							{
+								position835, tokenIndex835 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l835
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l835
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l835
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l835
+								}
+								position++
+								goto l836
+							l835:
+								position, tokenIndex = position835, tokenIndex835
+							}
+						l836:
+							{
+								position837, tokenIndex837 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l837
+								}
+								position++
+								goto l758
+							l837:
+								position, tokenIndex = position837, tokenIndex837
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction190, position)
+							}
+						case 'v':
+							if buffer[position] != rune('v') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							{
+								position839, tokenIndex839 := position, tokenIndex
+								if !_rules[ruleS]() {
+									goto l839
+								}
+								goto l840
+							l839:
+								position, tokenIndex = position839, tokenIndex839
+							}
+						l840:
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							{
+								position841, tokenIndex841 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l841
+								}
+								position++
+								goto l758
+							l841:
+								position, tokenIndex = position841, tokenIndex841
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction189, position)
+							}
+						case 'h':
+							if buffer[position] != rune('h') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('w') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							{
+								position843, tokenIndex843 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l843
+								}
+								position++
+								goto l758
+							l843:
+								position, tokenIndex = position843, tokenIndex843
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction188, position)
+							}
+						case 'd', 'g':
+							{
+								position845, tokenIndex845 := position, tokenIndex
+								if buffer[position] != rune('g') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune(' ') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune(' ') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l846
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l846
+								}
+								position++
+								goto l845
+							l846:
+								position, tokenIndex = position845, tokenIndex845
+								if buffer[position] != rune('d') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune(' ') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune(' ') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('g') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune(' ') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l758
+								}
+								position++
+							}
+						l845:
+							{
+								position847, tokenIndex847 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l847
+								}
+								position++
+								goto l758
+							l847:
+								position, tokenIndex = position847, tokenIndex847
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction186, position)
+							}
+						case 'p', 'w':
+							{
+								position849, tokenIndex849 := position, tokenIndex
+								if buffer[position] != rune('w') {
+									goto l850
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l850
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l850
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l850
+								}
+								position++
+								goto l849
+							l850:
+								position, tokenIndex = position849, tokenIndex849
+								if buffer[position] != rune('p') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l758
+								}
+								position++
+							}
+						l849:
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('m') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							{
+								position851, tokenIndex851 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l851
+								}
+								position++
+								goto l758
+							l851:
+								position, tokenIndex = position851, tokenIndex851
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction185, position)
+							}
+						case 'a':
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							{
+								position853, tokenIndex853 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l853
+								}
+								position++
+								goto l758
+							l853:
+								position, tokenIndex = position853, tokenIndex853
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction184, position)
+							}
+						case 'm':
+							if buffer[position] != rune('m') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							{
+								position855, tokenIndex855 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l855
+								}
+								position++
+								goto l758
+							l855:
+								position, tokenIndex = position855, tokenIndex855
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction183, position)
+							}
+						case 's':
+							if buffer[position] != rune('s') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('m') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('m') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('k') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							{
+								position857, tokenIndex857 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l857
+								}
+								position++
+								goto l758
+							l857:
+								position, tokenIndex = position857, tokenIndex857
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction182, position)
+							}
+						case 'e':
+							if buffer[position] != rune('e') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('m') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('k') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							{
+								position859, tokenIndex859 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l859
+								}
+								position++
+								goto l758
+							l859:
+								position, tokenIndex = position859, tokenIndex859
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction180, position)
+							}
+						case 'l':
+							if buffer[position] != rune('l') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l758
+							}
+							position++
+							{
+								position861, tokenIndex861 := position, tokenIndex
+								if buffer[position] != rune('u') {
+									goto l861
+								}
+								position++
+								goto l862
+							l861:
+								position, tokenIndex = position861, tokenIndex861
+							}
+						l862:
+							if buffer[position] != rune('r') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							{
+								position863, tokenIndex863 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l863
+								}
+								position++
+								goto l758
+							l863:
+								position, tokenIndex = position863, tokenIndex863
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction179, position)
+							}
+						case 'n':
+							if buffer[position] != rune('n') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('w') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l758
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l758
+							}
+							position++
+							{
+								position865, tokenIndex865 := position, tokenIndex
+								if !_rules[ruleS]() {
+									goto l865
+								}
+								goto l866
+							l865:
+								position, tokenIndex = position865, tokenIndex865
+							}
+						l866:
+							{
+								position867, tokenIndex867 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l867
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l867
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l867
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l867
+								}
+								position++
+								goto l868
+							l867:
+								position, tokenIndex = position867, tokenIndex867
+							}
+						l868:
+							{
+								position869, tokenIndex869 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l869
+								}
+								position++
+								goto l758
+							l869:
+								position, tokenIndex = position869, tokenIndex869
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction167, position)
+							}
+						default:
+							{
+								position871, tokenIndex871 := position, tokenIndex
+								if buffer[position] != rune('i') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune(' ') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l872
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l872
+								}
+								position++
+								goto l871
+							l872:
+								position, tokenIndex = position871, tokenIndex871
+								{
+									position873, tokenIndex873 := position, tokenIndex
+									if buffer[position] != rune('f') {
+										goto l874
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l874
+									}
+									position++
+									if buffer[position] != rune('u') {
+										goto l874
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l874
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l874
+									}
+									position++
+									if buffer[position] != rune('h') {
+										goto l874
+									}
+									position++
+									goto l873
+								l874:
+									position, tokenIndex = position873, tokenIndex873
+									if buffer[position] != rune('4') {
+										goto l758
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l758
+									}
+									position++
+									if buffer[position] != rune('h') {
+										goto l758
+									}
+									position++
+								}
+							l873:
+								if buffer[position] != rune(' ') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune(' ') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('j') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l758
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l758
+								}
+								position++
+							}
+						l871:
+							{
+								position875, tokenIndex875 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l875
+								}
+								position++
+								goto l758
+							l875:
+								position, tokenIndex = position875, tokenIndex875
+							}
+							if !_rules[rule_]() {
+								goto l758
+							}
+							{
+								add(ruleAction178, position)
+							}
+						}
+					}
+
+				}
+			l760:
+				add(ruleHolidayName, position759)
+			}
+			return true
+		l758:
+			position, tokenIndex = position758, tokenIndex758
+			return false
+		},
+		/* 55 S <- <(('\'' ('s' / 'S')) / (('s' / 'S') '\'') / 's')> */
+		func() bool {
+			position877, tokenIndex877 := position, tokenIndex
+			{
+				position878 := position
+				{
+					position879, tokenIndex879 := position, tokenIndex
+					if buffer[position] != rune('\'') {
+						goto l880
+					}
+					position++
+					{
+						position881, tokenIndex881 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l882
+						}
+						position++
+						goto l881
+					l882:
+						position, tokenIndex = position881, tokenIndex881
+						if buffer[position] != rune('S') {
+							goto l880
+						}
+						position++
+					}
+				l881:
+					goto l879
+				l880:
+					position, tokenIndex = position879, tokenIndex879
+					{
+						position884, tokenIndex884 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l885
+						}
+						position++
+						goto l884
+					l885:
+						position, tokenIndex = position884, tokenIndex884
+						if buffer[position] != rune('S') {
+							goto l883
+						}
+						position++
+					}
+				l884:
+					if buffer[position] != rune('\'') {
+						goto l883
+					}
+					position++
+					goto l879
+				l883:
+					position, tokenIndex = position879, tokenIndex879
+					if buffer[position] != rune('s') {
+						goto l877
+					}
+					position++
+				}
+			l879:
+				add(ruleS, position878)
+			}
+			return true
+		l877:
+			position, tokenIndex = position877, tokenIndex877
+			return false
+		},
+		/* 56 Nth <- <(((('f' 'i' 'r' 's' 't') / ('1' 's' 't')) _ Action194) / ((('f' 'o' 'u' 'r' 't' 'h') / ('4' 't' 'h')) _ Action197) / ((&('l') ('l' 'a' 's' 't' _ Action199)) | (&('5' | 'f') ((('f' 'i' 'f' 't' 'h') / ('5' 't' 'h')) _ Action198)) | (&('3' | 't') ((('t' 'h' 'i' 'r' 'd') / ('3' 'r' 'd')) _ Action196)) | (&('2' | 's') ((('s' 'e' 'c' 'o' 'n' 'd') / ('2' 'n' 'd')) _ Action195))))> */
+		func() bool {
+			position886, tokenIndex886 := position, tokenIndex
+			{
+				position887 := position
+				{
+					position888, tokenIndex888 := position, tokenIndex
+					{
+						position890, tokenIndex890 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l891
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l891
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l891
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l891
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l891
+						}
+						position++
+						goto l890
+					l891:
+						position, tokenIndex = position890, tokenIndex890
+						if buffer[position] != rune('1') {
+							goto l889
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l889
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l889
+						}
+						position++
+					}
+				l890:
+					if !_rules[rule_]() {
+						goto l889
+					}
+					{
+						add(ruleAction194, position)
+					}
+					goto l888
+				l889:
+					position, tokenIndex = position888, tokenIndex888
+					{
+						position894, tokenIndex894 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l895
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l895
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l895
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l895
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l895
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l895
+						}
+						position++
+						goto l894
+					l895:
+						position, tokenIndex = position894, tokenIndex894
+						if buffer[position] != rune('4') {
+							goto l893
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l893
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l893
+						}
+						position++
+					}
+				l894:
+					if !_rules[rule_]() {
+						goto l893
+					}
+					{
+						add(ruleAction197, position)
+					}
+					goto l888
+				l893:
+					position, tokenIndex = position888, tokenIndex888
+					{
+						switch buffer[position] {
+						case 'l':
+							if buffer[position] != rune('l') {
+								goto l886
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l886
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l886
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l886
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l886
+							}
+							{
+								add(ruleAction199, position)
+							}
+						case '5', 'f':
+							{
+								position899, tokenIndex899 := position, tokenIndex
+								if buffer[position] != rune('f') {
+									goto l900
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l900
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l900
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l900
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l900
+								}
+								position++
+								goto l899
+							l900:
+								position, tokenIndex = position899, tokenIndex899
+								if buffer[position] != rune('5') {
+									goto l886
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l886
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l886
+								}
+								position++
+							}
+						l899:
+							if !_rules[rule_]() {
+								goto l886
+							}
+							{
+								add(ruleAction198, position)
+							}
+						case '3', 't':
+							{
+								position902, tokenIndex902 := position, tokenIndex
+								if buffer[position] != rune('t') {
+									goto l903
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l903
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l903
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l903
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l903
+								}
+								position++
+								goto l902
+							l903:
+								position, tokenIndex = position902, tokenIndex902
+								if buffer[position] != rune('3') {
+									goto l886
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l886
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l886
+								}
+								position++
+							}
+						l902:
+							if !_rules[rule_]() {
+								goto l886
+							}
+							{
+								add(ruleAction196, position)
+							}
+						default:
+							{
+								position905, tokenIndex905 := position, tokenIndex
+								if buffer[position] != rune('s') {
+									goto l906
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l906
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l906
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l906
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l906
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l906
+								}
+								position++
+								goto l905
+							l906:
+								position, tokenIndex = position905, tokenIndex905
+								if buffer[position] != rune('2') {
+									goto l886
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l886
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l886
+								}
+								position++
+							}
+						l905:
+							if !_rules[rule_]() {
+								goto l886
+							}
+							{
+								add(ruleAction195, position)
+							}
+						}
+					}
+
+				}
+			l888:
+				add(ruleNth, position887)
+			}
+			return true
+		l886:
+			position, tokenIndex = position886, tokenIndex886
+			return false
+		},
+		/* 57 Weekday <- <(('s' 'u' 'n' 'd' 'a' 'y' 's'? _ Action200) / ('t' 'u' 'e' 's' 'd' 'a' 'y' 's'? _ Action202) / ((&('s') ('s' 'a' 't' 'u' 'r' 'd' 'a' 'y' 's'? _ Action206)) | (&('f') ('f' 'r' 'i' 'd' 'a' 'y' 's'? _ Action205)) | (&('t') ('t' 'h' 'u' 'r' 's' 'd' 'a' 'y' 's'? _ Action204)) | (&('w') ('w' 'e' 'd' 'n' 'e' 's' 'd' 'a' 'y' 's'? _ Action203)) | (&('m') ('m' 'o' 'n' 'd' 'a' 'y' 's'? _ Action201))))> */
+		func() bool {
+			position908, tokenIndex908 := position, tokenIndex
+			{
+				position909 := position
+				{
+					position910, tokenIndex910 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l911
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l911
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l911
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l911
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l911
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l911
+					}
+					position++
+					{
+						position912, tokenIndex912 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l912
+						}
+						position++
+						goto l913
+					l912:
+						position, tokenIndex = position912, tokenIndex912
+					}
+				l913:
+					if !_rules[rule_]() {
+						goto l911
+					}
+					{
+						add(ruleAction200, position)
+					}
+					goto l910
+				l911:
+					position, tokenIndex = position910, tokenIndex910
+					if buffer[position] != rune('t') {
+						goto l915
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l915
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l915
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l915
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l915
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l915
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l915
+					}
+					position++
+					{
+						position916, tokenIndex916 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l916
+						}
+						position++
+						goto l917
+					l916:
+						position, tokenIndex = position916, tokenIndex916
+					}
+				l917:
+					if !_rules[rule_]() {
+						goto l915
+					}
+					{
+						add(ruleAction202, position)
+					}
+					goto l910
+				l915:
+					position, tokenIndex = position910, tokenIndex910
+					{
+						switch buffer[position] {
+						case 's':
+							if buffer[position] != rune('s') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l908
+							}
+							position++
+							{
+								position920, tokenIndex920 := position, tokenIndex
+								if buffer[position] != rune('s') {
+									goto l920
+								}
+								position++
+								goto l921
+							l920:
+								position, tokenIndex = position920, tokenIndex920
+							}
+						l921:
+							if !_rules[rule_]() {
+								goto l908
+							}
+							{
+								add(ruleAction206, position)
+							}
+						case 'f':
+							if buffer[position] != rune('f') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l908
+							}
+							position++
+							{
+								position923, tokenIndex923 := position, tokenIndex
+								if buffer[position] != rune('s') {
+									goto l923
+								}
+								position++
+								goto l924
+							l923:
+								position, tokenIndex = position923, tokenIndex923
+							}
+						l924:
+							if !_rules[rule_]() {
+								goto l908
+							}
+							{
+								add(ruleAction205, position)
+							}
+						case 't':
+							if buffer[position] != rune('t') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l908
+							}
+							position++
+							{
+								position926, tokenIndex926 := position, tokenIndex
+								if buffer[position] != rune('s') {
+									goto l926
+								}
+								position++
+								goto l927
+							l926:
+								position, tokenIndex = position926, tokenIndex926
+							}
+						l927:
+							if !_rules[rule_]() {
+								goto l908
+							}
+							{
+								add(ruleAction204, position)
+							}
+						case 'w':
+							if buffer[position] != rune('w') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l908
+							}
+							position++
+							{
+								position929, tokenIndex929 := position, tokenIndex
+								if buffer[position] != rune('s') {
+									goto l929
+								}
+								position++
+								goto l930
+							l929:
+								position, tokenIndex = position929, tokenIndex929
+							}
+						l930:
+							if !_rules[rule_]() {
+								goto l908
+							}
+							{
+								add(ruleAction203, position)
+							}
+						default:
+							if buffer[position] != rune('m') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l908
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l908
+							}
+							position++
+							{
+								position932, tokenIndex932 := position, tokenIndex
+								if buffer[position] != rune('s') {
+									goto l932
+								}
+								position++
+								goto l933
+							l932:
+								position, tokenIndex = position932, tokenIndex932
+							}
+						l933:
+							if !_rules[rule_]() {
+								goto l908
+							}
+							{
+								add(ruleAction201, position)
+							}
+						}
+					}
+
+				}
+			l910:
+				add(ruleWeekday, position909)
+			}
+			return true
+		l908:
+			position, tokenIndex = position908, tokenIndex908
+			return false
+		},
+		/* 58 PluralWeekday <- <((('s' 'u' 'n') / ('t' 'u' 'e' 's') / ((&('s') ('s' 'a' 't' 'u' 'r')) | (&('f') ('f' 'r' 'i')) | (&('t') ('t' 'h' 'u' 'r' 's')) | (&('w') ('w' 'e' 'd' 'n' 'e' 's')) | (&('m') ('m' 'o' 'n')))) ('d' 'a' 'y' 's') ![a-z])> */
+		nil,
+		/* 59 Month <- <(('j' 'a' 'n' 'u' 'a' 'r' 'y' _ Action207) / ('m' 'a' 'r' 'c' 'h' _ Action209) / ('a' 'p' 'r' 'i' 'l' _ Action210) / ('j' 'u' 'n' 'e' _ Action212) / ((&('d') ('d' 'e' 'c' 'e' 'm' 'b' 'e' 'r' _ Action218)) | (&('n') ('n' 'o' 'v' 'e' 'm' 'b' 'e' 'r' _ Action217)) | (&('o') ('o' 'c' 't' 'o' 'b' 'e' 'r' _ Action216)) | (&('s') ('s' 'e' 'p' 't' 'e' 'm' 'b' 'e' 'r' _ Action215)) | (&('a') ('a' 'u' 'g' 'u' 's' 't' _ Action214)) | (&('j') ('j' 'u' 'l' 'y' _ Action213)) | (&('m') ('m' 'a' 'y' _ Action211)) | (&('f') ('f' 'e' 'b' 'r' 'u' 'a' 'r' 'y' _ Action208))))> */
+		func() bool {
+			position936, tokenIndex936 := position, tokenIndex
+			{
+				position937 := position
+				{
+					position938, tokenIndex938 := position, tokenIndex
+					if buffer[position] != rune('j') {
+						goto l939
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l939
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l939
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l939
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l939
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l939
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l939
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l939
+					}
+					{
+						add(ruleAction207, position)
+					}
+					goto l938
+				l939:
+					position, tokenIndex = position938, tokenIndex938
+					if buffer[position] != rune('m') {
+						goto l941
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l941
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l941
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l941
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l941
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l941
+					}
+					{
+						add(ruleAction209, position)
+					}
+					goto l938
+				l941:
+					position, tokenIndex = position938, tokenIndex938
+					if buffer[position] != rune('a') {
+						goto l943
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l943
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l943
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l943
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l943
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l943
+					}
+					{
+						add(ruleAction210, position)
+					}
+					goto l938
+				l943:
+					position, tokenIndex = position938, tokenIndex938
+					if buffer[position] != rune('j') {
+						goto l945
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l945
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l945
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l945
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l945
+					}
+					{
+						add(ruleAction212, position)
+					}
+					goto l938
+				l945:
+					position, tokenIndex = position938, tokenIndex938
+					{
+						switch buffer[position] {
+						case 'd':
+							if buffer[position] != rune('d') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('m') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l936
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l936
+							}
+							{
+								add(ruleAction218, position)
+							}
+						case 'n':
+							if buffer[position] != rune('n') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('v') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('m') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l936
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l936
+							}
+							{
+								add(ruleAction217, position)
+							}
+						case 'o':
+							if buffer[position] != rune('o') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l936
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l936
+							}
+							{
+								add(ruleAction216, position)
+							}
+						case 's':
+							if buffer[position] != rune('s') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('p') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('m') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l936
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l936
+							}
+							{
+								add(ruleAction215, position)
+							}
+						case 'a':
+							if buffer[position] != rune('a') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l936
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l936
+							}
+							{
+								add(ruleAction214, position)
+							}
+						case 'j':
+							if buffer[position] != rune('j') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l936
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l936
+							}
+							{
+								add(ruleAction213, position)
+							}
+						case 'm':
+							if buffer[position] != rune('m') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l936
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l936
+							}
+							{
+								add(ruleAction211, position)
+							}
+						default:
+							if buffer[position] != rune('f') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l936
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l936
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l936
+							}
+							{
+								add(ruleAction208, position)
+							}
+						}
+					}
+
+				}
+			l938:
+				add(ruleMonth, position937)
+			}
+			return true
+		l936:
+			position, tokenIndex = position936, tokenIndex936
+			return false
+		},
+		/* 60 In <- <(IN Action219)> */
+		func() bool {
+			position956, tokenIndex956 := position, tokenIndex
+			{
+				position957 := position
+				{
+					position958 := position
+					{
+						position959, tokenIndex959 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l960
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l960
+						}
+						position++
+						if buffer[position] != rune(' ') {
+							goto l960
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l960
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l960
+						}
+						position++
+						goto l959
+					l960:
+						position, tokenIndex = position959, tokenIndex959
+						if buffer[position] != rune('i') {
+							goto l961
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l961
+						}
+						position++
+						if buffer[position] != rune(' ') {
+							goto l961
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l961
+						}
+						position++
+						goto l959
+					l961:
+						position, tokenIndex = position959, tokenIndex959
+						if buffer[position] != rune('i') {
+							goto l962
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l962
+						}
+						position++
+						goto l959
+					l962:
+						position, tokenIndex = position959, tokenIndex959
+						if buffer[position] != rune('w') {
+							goto l956
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l956
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l956
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l956
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l956
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l956
+						}
+						position++
+					}
+				l959:
+					if !_rules[rule_]() {
+						goto l956
+					}
+					add(ruleIN, position958)
+				}
+				{
+					add(ruleAction219, position)
+				}
+				add(ruleIn, position957)
+			}
+			return true
+		l956:
+			position, tokenIndex = position956, tokenIndex956
+			return false
+		},
+		/* 61 One <- <(((('t' 'h' 'e') / ('a' 'n') / 'a') ![a-z] _)? Action220)> */
+		func() bool {
+			{
+				position965 := position
+				{
+					position966, tokenIndex966 := position, tokenIndex
+					{
+						position968, tokenIndex968 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l969
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l969
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l969
+						}
+						position++
+						goto l968
+					l969:
+						position, tokenIndex = position968, tokenIndex968
+						if buffer[position] != rune('a') {
+							goto l970
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l970
+						}
+						position++
+						goto l968
+					l970:
+						position, tokenIndex = position968, tokenIndex968
+						if buffer[position] != rune('a') {
+							goto l966
+						}
+						position++
+					}
+				l968:
+					{
+						position971, tokenIndex971 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l971
+						}
+						position++
+						goto l966
+					l971:
+						position, tokenIndex = position971, tokenIndex971
+					}
+					if !_rules[rule_]() {
+						goto l966
+					}
+					goto l967
+				l966:
+					position, tokenIndex = position966, tokenIndex966
+				}
+			l967:
+				{
+					add(ruleAction220, position)
+				}
+				add(ruleOne, position965)
+			}
+			return true
+		},
+		/* 62 Last <- <(LAST Action221)> */
+		func() bool {
+			position973, tokenIndex973 := position, tokenIndex
+			{
+				position974 := position
+				if !_rules[ruleLAST]() {
+					goto l973
+				}
+				{
+					add(ruleAction221, position)
+				}
+				add(ruleLast, position974)
+			}
+			return true
+		l973:
+			position, tokenIndex = position973, tokenIndex973
+			return false
+		},
+		/* 63 Next <- <(NEXT Action222)> */
+		func() bool {
+			position976, tokenIndex976 := position, tokenIndex
+			{
+				position977 := position
+				if !_rules[ruleNEXT]() {
+					goto l976
+				}
+				{
+					add(ruleAction222, position)
+				}
+				add(ruleNext, position977)
+			}
+			return true
+		l976:
+			position, tokenIndex = position976, tokenIndex976
+			return false
+		},
+		/* 64 Ordinal <- <(((&('t') ('t' 'h')) | (&('r') ('r' 'd')) | (&('n') ('n' 'd')) | (&('s') ('s' 't'))) _)> */
+		func() bool {
+			position979, tokenIndex979 := position, tokenIndex
+			{
+				position980 := position
+				{
+					switch buffer[position] {
+					case 't':
+						if buffer[position] != rune('t') {
+							goto l979
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l979
+						}
+						position++
+					case 'r':
+						if buffer[position] != rune('r') {
+							goto l979
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l979
+						}
+						position++
+					case 'n':
+						if buffer[position] != rune('n') {
+							goto l979
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l979
+						}
+						position++
+					default:
+						if buffer[position] != rune('s') {
+							goto l979
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l979
+						}
+						position++
+					}
+				}
+
+				if !_rules[rule_]() {
+					goto l979
+				}
+				add(ruleOrdinal, position980)
+			}
+			return true
+		l979:
+			position, tokenIndex = position979, tokenIndex979
+			return false
+		},
+		/* 65 Word <- <(<[a-z]+> _ Action223)> */
+		func() bool {
+			position982, tokenIndex982 := position, tokenIndex
+			{
+				position983 := position
+				{
+					position984 := position
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l982
+					}
+					position++
+				l985:
+					{
+						position986, tokenIndex986 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l986
+						}
+						position++
+						goto l985
+					l986:
+						position, tokenIndex = position986, tokenIndex986
+					}
+					add(rulePegText, position984)
+				}
+				if !_rules[rule_]() {
+					goto l982
+				}
+				{
+					add(ruleAction223, position)
+				}
+				add(ruleWord, position983)
+			}
+			return true
+		l982:
+			position, tokenIndex = position982, tokenIndex982
+			return false
+		},
+		/* 66 YEARS <- <('y' 'e' 'a' 'r' 's'? _)> */
+		func() bool {
+			position988, tokenIndex988 := position, tokenIndex
+			{
+				position989 := position
+				if buffer[position] != rune('y') {
+					goto l988
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l988
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l988
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l988
+				}
+				position++
+				{
+					position990, tokenIndex990 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l990
+					}
+					position++
+					goto l991
+				l990:
+					position, tokenIndex = position990, tokenIndex990
+				}
+			l991:
+				if !_rules[rule_]() {
+					goto l988
+				}
+				add(ruleYEARS, position989)
+			}
+			return true
+		l988:
+			position, tokenIndex = position988, tokenIndex988
+			return false
+		},
+		/* 67 QUARTERS <- <('q' 'u' 'a' 'r' 't' 'e' 'r' 's'? _)> */
+		nil,
+		/* 68 MONTHS <- <('m' 'o' 'n' 't' 'h' 's'? _)> */
+		func() bool {
+			position993, tokenIndex993 := position, tokenIndex
+			{
+				position994 := position
+				if buffer[position] != rune('m') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l993
+				}
+				position++
+				{
+					position995, tokenIndex995 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l995
+					}
+					position++
+					goto l996
+				l995:
+					position, tokenIndex = position995, tokenIndex995
+				}
+			l996:
+				if !_rules[rule_]() {
+					goto l993
+				}
+				add(ruleMONTHS, position994)
+			}
+			return true
+		l993:
+			position, tokenIndex = position993, tokenIndex993
+			return false
+		},
+		/* 69 WEEKS <- <('w' 'e' 'e' 'k' 's'? _)> */
+		func() bool {
+			position997, tokenIndex997 := position, tokenIndex
+			{
+				position998 := position
+				if buffer[position] != rune('w') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('k') {
+					goto l997
+				}
+				position++
+				{
+					position999, tokenIndex999 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l999
+					}
+					position++
+					goto l1000
+				l999:
+					position, tokenIndex = position999, tokenIndex999
+				}
+			l1000:
+				if !_rules[rule_]() {
+					goto l997
+				}
+				add(ruleWEEKS, position998)
+			}
+			return true
+		l997:
+			position, tokenIndex = position997, tokenIndex997
+			return false
+		},
+		/* 70 DAYS <- <('d' 'a' 'y' 's'? _)> */
+		func() bool {
+			position1001, tokenIndex1001 := position, tokenIndex
+			{
+				position1002 := position
+				if buffer[position] != rune('d') {
+					goto l1001
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1001
+				}
+				position++
+				if buffer[position] != rune('y') {
+					goto l1001
+				}
+				position++
+				{
+					position1003, tokenIndex1003 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1003
+					}
+					position++
+					goto l1004
+				l1003:
+					position, tokenIndex = position1003, tokenIndex1003
+				}
+			l1004:
+				if !_rules[rule_]() {
+					goto l1001
+				}
+				add(ruleDAYS, position1002)
+			}
+			return true
+		l1001:
+			position, tokenIndex = position1001, tokenIndex1001
+			return false
+		},
+		/* 71 BUSINESS_DAYS <- <((('b' 'u' 's' 'i' 'n' 'e' 's' 's' _) / ('w' 'o' 'r' 'k' 'i' 'n' 'g' _) / ('w' 'e' 'e' 'k')) ('d' 'a' 'y') 's'? _)> */
+		func() bool {
+			position1005, tokenIndex1005 := position, tokenIndex
+			{
+				position1006 := position
+				{
+					position1007, tokenIndex1007 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l1008
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l1008
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1008
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1008
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1008
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1008
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1008
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1008
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l1008
+					}
+					goto l1007
+				l1008:
+					position, tokenIndex = position1007, tokenIndex1007
+					if buffer[position] != rune('w') {
+						goto l1009
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1009
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l1009
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l1009
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1009
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1009
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l1009
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l1009
+					}
+					goto l1007
+				l1009:
+					position, tokenIndex = position1007, tokenIndex1007
+					if buffer[position] != rune('w') {
+						goto l1005
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1005
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1005
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l1005
+					}
+					position++
+				}
+			l1007:
+				if buffer[position] != rune('d') {
+					goto l1005
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1005
+				}
+				position++
+				if buffer[position] != rune('y') {
+					goto l1005
+				}
+				position++
+				{
+					position1010, tokenIndex1010 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1010
+					}
+					position++
+					goto l1011
+				l1010:
+					position, tokenIndex = position1010, tokenIndex1010
+				}
+			l1011:
+				if !_rules[rule_]() {
+					goto l1005
+				}
+				add(ruleBUSINESS_DAYS, position1006)
+			}
+			return true
+		l1005:
+			position, tokenIndex = position1005, tokenIndex1005
+			return false
+		},
+		/* 72 BUSINESS_HOURS <- <((('b' 'u' 's' 'i' 'n' 'e' 's' 's') / ('w' 'o' 'r' 'k' 'i' 'n' 'g')) _ ('h' 'o' 'u' 'r') 's'? _)> */
+		func() bool {
+			position1012, tokenIndex1012 := position, tokenIndex
+			{
+				position1013 := position
+				{
+					position1014, tokenIndex1014 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l1015
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l1015
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1015
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1015
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1015
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1015
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1015
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1015
+					}
+					position++
+					goto l1014
+				l1015:
+					position, tokenIndex = position1014, tokenIndex1014
+					if buffer[position] != rune('w') {
+						goto l1012
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1012
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l1012
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l1012
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1012
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1012
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l1012
+					}
+					position++
+				}
+			l1014:
+				if !_rules[rule_]() {
+					goto l1012
+				}
+				if buffer[position] != rune('h') {
+					goto l1012
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1012
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l1012
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1012
+				}
+				position++
+				{
+					position1016, tokenIndex1016 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1016
+					}
+					position++
+					goto l1017
+				l1016:
+					position, tokenIndex = position1016, tokenIndex1016
+				}
+			l1017:
+				if !_rules[rule_]() {
+					goto l1012
+				}
+				add(ruleBUSINESS_HOURS, position1013)
+			}
+			return true
+		l1012:
+			position, tokenIndex = position1012, tokenIndex1012
+			return false
+		},
+		/* 73 BUSINESS_MINUTES <- <((('b' 'u' 's' 'i' 'n' 'e' 's' 's') / ('w' 'o' 'r' 'k' 'i' 'n' 'g')) _ ('m' 'i' 'n' 'u' 't' 'e') 's'? _)> */
+		func() bool {
+			position1018, tokenIndex1018 := position, tokenIndex
+			{
+				position1019 := position
+				{
+					position1020, tokenIndex1020 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l1021
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l1021
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1021
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1021
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1021
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1021
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1021
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1021
+					}
+					position++
+					goto l1020
+				l1021:
+					position, tokenIndex = position1020, tokenIndex1020
+					if buffer[position] != rune('w') {
+						goto l1018
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1018
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l1018
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l1018
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1018
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1018
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l1018
+					}
+					position++
+				}
+			l1020:
+				if !_rules[rule_]() {
+					goto l1018
+				}
+				if buffer[position] != rune('m') {
+					goto l1018
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1018
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l1018
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l1018
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1018
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1018
+				}
+				position++
+				{
+					position1022, tokenIndex1022 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1022
+					}
+					position++
+					goto l1023
+				l1022:
+					position, tokenIndex = position1022, tokenIndex1022
+				}
+			l1023:
+				if !_rules[rule_]() {
+					goto l1018
+				}
+				add(ruleBUSINESS_MINUTES, position1019)
+			}
+			return true
+		l1018:
+			position, tokenIndex = position1018, tokenIndex1018
+			return false
+		},
+		/* 74 HOURS <- <('h' 'o' 'u' 'r' 's'? _)> */
+		func() bool {
+			position1024, tokenIndex1024 := position, tokenIndex
+			{
+				position1025 := position
+				if buffer[position] != rune('h') {
+					goto l1024
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1024
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l1024
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1024
+				}
+				position++
+				{
+					position1026, tokenIndex1026 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1026
+					}
+					position++
+					goto l1027
+				l1026:
+					position, tokenIndex = position1026, tokenIndex1026
+				}
+			l1027:
+				if !_rules[rule_]() {
+					goto l1024
+				}
+				add(ruleHOURS, position1025)
+			}
+			return true
+		l1024:
+			position, tokenIndex = position1024, tokenIndex1024
+			return false
+		},
+		/* 75 MINUTES <- <('m' 'i' 'n' 'u' 't' 'e' 's'? _)> */
+		func() bool {
+			position1028, tokenIndex1028 := position, tokenIndex
+			{
+				position1029 := position
+				if buffer[position] != rune('m') {
+					goto l1028
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1028
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l1028
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l1028
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1028
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1028
+				}
+				position++
+				{
+					position1030, tokenIndex1030 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1030
+					}
+					position++
+					goto l1031
+				l1030:
+					position, tokenIndex = position1030, tokenIndex1030
+				}
+			l1031:
+				if !_rules[rule_]() {
+					goto l1028
+				}
+				add(ruleMINUTES, position1029)
+			}
+			return true
+		l1028:
+			position, tokenIndex = position1028, tokenIndex1028
+			return false
+		},
+		/* 76 YESTERDAY <- <('y' 'e' 's' 't' 'e' 'r' 'd' 'a' 'y' _)> */
+		func() bool {
+			position1032, tokenIndex1032 := position, tokenIndex
+			{
+				position1033 := position
+				if buffer[position] != rune('y') {
+					goto l1032
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1032
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1032
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1032
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1032
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1032
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l1032
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1032
+				}
+				position++
+				if buffer[position] != rune('y') {
+					goto l1032
+				}
+				position++
+				if !_rules[rule_]() {
+					goto l1032
+				}
+				add(ruleYESTERDAY, position1033)
+			}
+			return true
+		l1032:
+			position, tokenIndex = position1032, tokenIndex1032
+			return false
+		},
+		/* 77 TOMORROW <- <('t' 'o' 'm' 'o' 'r' 'r' 'o' 'w' _)> */
+		func() bool {
+			position1034, tokenIndex1034 := position, tokenIndex
+			{
+				position1035 := position
+				if buffer[position] != rune('t') {
+					goto l1034
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1034
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l1034
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1034
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1034
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1034
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1034
+				}
+				position++
+				if buffer[position] != rune('w') {
+					goto l1034
+				}
+				position++
+				if !_rules[rule_]() {
+					goto l1034
+				}
+				add(ruleTOMORROW, position1035)
+			}
+			return true
+		l1034:
+			position, tokenIndex = position1034, tokenIndex1034
+			return false
+		},
+		/* 78 TODAY <- <('t' 'o' 'd' 'a' 'y' _)> */
+		func() bool {
+			position1036, tokenIndex1036 := position, tokenIndex
+			{
+				position1037 := position
+				if buffer[position] != rune('t') {
+					goto l1036
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1036
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l1036
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1036
+				}
+				position++
+				if buffer[position] != rune('y') {
+					goto l1036
+				}
+				position++
+				if !_rules[rule_]() {
+					goto l1036
+				}
+				add(ruleTODAY, position1037)
+			}
+			return true
+		l1036:
+			position, tokenIndex = position1036, tokenIndex1036
+			return false
+		},
+		/* 79 AGO <- <('a' 'g' 'o' _)> */
+		func() bool {
+			position1038, tokenIndex1038 := position, tokenIndex
+			{
+				position1039 := position
+				if buffer[position] != rune('a') {
+					goto l1038
+				}
+				position++
+				if buffer[position] != rune('g') {
+					goto l1038
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1038
+				}
+				position++
+				if !_rules[rule_]() {
+					goto l1038
+				}
+				add(ruleAGO, position1039)
+			}
+			return true
+		l1038:
+			position, tokenIndex = position1038, tokenIndex1038
+			return false
+		},
+		/* 80 FROM_NOW <- <('f' 'r' 'o' 'm' ' ' 'n' 'o' 'w' _)> */
+		func() bool {
+			position1040, tokenIndex1040 := position, tokenIndex
+			{
+				position1041 := position
+				if buffer[position] != rune('f') {
+					goto l1040
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1040
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1040
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l1040
+				}
+				position++
+				if buffer[position] != rune(' ') {
+					goto l1040
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l1040
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1040
+				}
+				position++
+				if buffer[position] != rune('w') {
+					goto l1040
+				}
+				position++
+				if !_rules[rule_]() {
+					goto l1040
+				}
+				add(ruleFROM_NOW, position1041)
+			}
+			return true
+		l1040:
+			position, tokenIndex = position1040, tokenIndex1040
+			return false
+		},
+		/* 81 NOW <- <('n' 'o' 'w' _)> */
+		nil,
+		/* 82 AM <- <((('a' '.' 'm' '.') / ('a' '.' 'm') / ('a' 'm') / (&{ p.afterDigit(position) } 'a')) ![a-z] _)> */
+		func() bool {
+			position1043, tokenIndex1043 := position, tokenIndex
+			{
+				position1044 := position
+				{
+					position1045, tokenIndex1045 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1046
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l1046
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l1046
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l1046
+					}
+					position++
+					goto l1045
+				l1046:
+					position, tokenIndex = position1045, tokenIndex1045
+					if buffer[position] != rune('a') {
+						goto l1047
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l1047
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l1047
+					}
+					position++
+					goto l1045
+				l1047:
+					position, tokenIndex = position1045, tokenIndex1045
+					if buffer[position] != rune('a') {
+						goto l1048
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l1048
+					}
+					position++
+					goto l1045
+				l1048:
+					position, tokenIndex = position1045, tokenIndex1045
+					if !(p.afterDigit(position)) {
+						goto l1043
+					}
+					if buffer[position] != rune('a') {
+						goto l1043
+					}
+					position++
+				}
+			l1045:
+				{
+					position1049, tokenIndex1049 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l1049
+					}
+					position++
+					goto l1043
+				l1049:
+					position, tokenIndex = position1049, tokenIndex1049
+				}
+				if !_rules[rule_]() {
+					goto l1043
+				}
+				add(ruleAM, position1044)
+			}
+			return true
+		l1043:
+			position, tokenIndex = position1043, tokenIndex1043
+			return false
+		},
+		/* 83 PM <- <((('p' '.' 'm' '.') / ('p' '.' 'm') / ('p' 'm') / (&{ p.afterDigit(position) } 'p')) ![a-z] _)> */
+		func() bool {
+			position1050, tokenIndex1050 := position, tokenIndex
+			{
+				position1051 := position
+				{
+					position1052, tokenIndex1052 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l1053
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l1053
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l1053
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l1053
+					}
+					position++
+					goto l1052
+				l1053:
+					position, tokenIndex = position1052, tokenIndex1052
+					if buffer[position] != rune('p') {
+						goto l1054
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l1054
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l1054
+					}
+					position++
+					goto l1052
+				l1054:
+					position, tokenIndex = position1052, tokenIndex1052
+					if buffer[position] != rune('p') {
+						goto l1055
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l1055
+					}
+					position++
+					goto l1052
+				l1055:
+					position, tokenIndex = position1052, tokenIndex1052
+					if !(p.afterDigit(position)) {
+						goto l1050
+					}
+					if buffer[position] != rune('p') {
+						goto l1050
+					}
+					position++
+				}
+			l1052:
+				{
+					position1056, tokenIndex1056 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l1056
+					}
+					position++
+					goto l1050
+				l1056:
+					position, tokenIndex = position1056, tokenIndex1056
+				}
+				if !_rules[rule_]() {
+					goto l1050
+				}
+				add(rulePM, position1051)
+			}
+			return true
+		l1050:
+			position, tokenIndex = position1050, tokenIndex1050
+			return false
+		},
+		/* 84 NEXT <- <('n' 'e' 'x' 't' _)> */
+		func() bool {
+			position1057, tokenIndex1057 := position, tokenIndex
+			{
+				position1058 := position
+				if buffer[position] != rune('n') {
+					goto l1057
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1057
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l1057
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1057
+				}
+				position++
+				if !_rules[rule_]() {
+					goto l1057
+				}
+				add(ruleNEXT, position1058)
+			}
+			return true
+		l1057:
+			position, tokenIndex = position1057, tokenIndex1057
+			return false
+		},
+		/* 85 IN <- <((('i' 'n' ' ' 'a' 'n') / ('i' 'n' ' ' 'a') / ('i' 'n') / ('w' 'i' 't' 'h' 'i' 'n')) _)> */
+		nil,
+		/* 86 LAST <- <((('l' 'a' 's' 't') / ('p' 'a' 's' 't') / ('p' 'r' 'e' 'v' 'i' 'o' 'u' 's')) _)> */
+		func() bool {
+			position1060, tokenIndex1060 := position, tokenIndex
+			{
+				position1061 := position
+				{
+					position1062, tokenIndex1062 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l1063
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l1063
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1063
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1063
+					}
+					position++
+					goto l1062
+				l1063:
+					position, tokenIndex = position1062, tokenIndex1062
+					if buffer[position] != rune('p') {
+						goto l1064
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l1064
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1064
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1064
 					}
-				l470:
-					if !_rules[rule_]() {
-						goto l467
+					position++
+					goto l1062
+				l1064:
+					position, tokenIndex = position1062, tokenIndex1062
+					if buffer[position] != rune('p') {
+						goto l1060
 					}
-					add(ruleIN, position469)
-				}
-				{
-					add(ruleAction75, position)
-				}
-				add(ruleIn, position468)
-			}
-			return true
-		l467:
-			position, tokenIndex = position467, tokenIndex467
-			return false
-		},
-		/* 19 Last <- <(LAST Action76)> */
-		func() bool {
-			position474, tokenIndex474 := position, tokenIndex
-			{
-				position475 := position
-				if !_rules[ruleLAST]() {
-					goto l474
-				}
-				{
-					add(ruleAction76, position)
-				}
-				add(ruleLast, position475)
-			}
-			return true
-		l474:
-			position, tokenIndex = position474, tokenIndex474
-			return false
-		},
-		/* 20 Next <- <(NEXT Action77)> */
-		func() bool {
-			position477, tokenIndex477 := position, tokenIndex
-			{
-				position478 := position
-				if !_rules[ruleNEXT]() {
-					goto l477
+					position++
+					if buffer[position] != rune('r') {
+						goto l1060
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1060
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l1060
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1060
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1060
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l1060
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1060
+					}
+					position++
 				}
-				{
-					add(ruleAction77, position)
+			l1062:
+				if !_rules[rule_]() {
+					goto l1060
 				}
-				add(ruleNext, position478)
+				add(ruleLAST, position1061)
 			}
 			return true
-		l477:
-			position, tokenIndex = position477, tokenIndex477
+		l1060:
+			position, tokenIndex = position1060, tokenIndex1060
 			return false
 		},
-		/* 21 Ordinal <- <(((&('t') ('t' 'h')) | (&('r') ('r' 'd')) | (&('n') ('n' 'd')) | (&('s') ('s' 't'))) _)> */
-		nil,
-		/* 22 Word <- <([a-z]+ _)> */
-		nil,
-		/* 23 YEARS <- <('y' 'e' 'a' 'r' 's'? _)> */
+		/* 87 THIS <- <('t' 'h' 'i' 's' _)> */
 		func() bool {
-			position482, tokenIndex482 := position, tokenIndex
+			position1065, tokenIndex1065 := position, tokenIndex
 			{
-				position483 := position
-				if buffer[position] != rune('y') {
-					goto l482
+				position1066 := position
+				if buffer[position] != rune('t') {
+					goto l1065
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l482
+				if buffer[position] != rune('h') {
+					goto l1065
 				}
 				position++
-				if buffer[position] != rune('a') {
-					goto l482
+				if buffer[position] != rune('i') {
+					goto l1065
 				}
 				position++
-				if buffer[position] != rune('r') {
-					goto l482
+				if buffer[position] != rune('s') {
+					goto l1065
 				}
 				position++
-				{
-					position484, tokenIndex484 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l484
-					}
-					position++
-					goto l485
-				l484:
-					position, tokenIndex = position484, tokenIndex484
-				}
-			l485:
 				if !_rules[rule_]() {
-					goto l482
+					goto l1065
 				}
-				add(ruleYEARS, position483)
+				add(ruleTHIS, position1066)
 			}
 			return true
-		l482:
-			position, tokenIndex = position482, tokenIndex482
+		l1065:
+			position, tokenIndex = position1065, tokenIndex1065
 			return false
 		},
-		/* 24 MONTHS <- <('m' 'o' 'n' 't' 'h' 's'? _)> */
+		/* 88 THE <- <('t' 'h' 'e' _)> */
 		func() bool {
-			position486, tokenIndex486 := position, tokenIndex
+			position1067, tokenIndex1067 := position, tokenIndex
 			{
-				position487 := position
-				if buffer[position] != rune('m') {
-					goto l486
-				}
-				position++
-				if buffer[position] != rune('o') {
-					goto l486
-				}
-				position++
-				if buffer[position] != rune('n') {
-					goto l486
-				}
-				position++
+				position1068 := position
 				if buffer[position] != rune('t') {
-					goto l486
+					goto l1067
 				}
 				position++
 				if buffer[position] != rune('h') {
-					goto l486
+					goto l1067
 				}
 				position++
-				{
-					position488, tokenIndex488 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l488
-					}
-					position++
-					goto l489
-				l488:
-					position, tokenIndex = position488, tokenIndex488
+				if buffer[position] != rune('e') {
+					goto l1067
 				}
-			l489:
+				position++
 				if !_rules[rule_]() {
-					goto l486
+					goto l1067
 				}
-				add(ruleMONTHS, position487)
+				add(ruleTHE, position1068)
 			}
 			return true
-		l486:
-			position, tokenIndex = position486, tokenIndex486
+		l1067:
+			position, tokenIndex = position1067, tokenIndex1067
 			return false
 		},
-		/* 25 WEEKS <- <('w' 'e' 'e' 'k' 's'? _)> */
+		/* 89 OF <- <('o' 'f' _)> */
 		func() bool {
-			position490, tokenIndex490 := position, tokenIndex
+			position1069, tokenIndex1069 := position, tokenIndex
 			{
-				position491 := position
-				if buffer[position] != rune('w') {
-					goto l490
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l490
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l490
+				position1070 := position
+				if buffer[position] != rune('o') {
+					goto l1069
 				}
 				position++
-				if buffer[position] != rune('k') {
-					goto l490
+				if buffer[position] != rune('f') {
+					goto l1069
 				}
 				position++
-				{
-					position492, tokenIndex492 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l492
-					}
-					position++
-					goto l493
-				l492:
-					position, tokenIndex = position492, tokenIndex492
-				}
-			l493:
 				if !_rules[rule_]() {
-					goto l490
+					goto l1069
 				}
-				add(ruleWEEKS, position491)
+				add(ruleOF, position1070)
 			}
 			return true
-		l490:
-			position, tokenIndex = position490, tokenIndex490
+		l1069:
+			position, tokenIndex = position1069, tokenIndex1069
 			return false
 		},
-		/* 26 DAYS <- <('d' 'a' 'y' 's'? _)> */
+		/* 90 ON <- <('o' 'n' _)> */
 		func() bool {
-			position494, tokenIndex494 := position, tokenIndex
+			position1071, tokenIndex1071 := position, tokenIndex
 			{
-				position495 := position
-				if buffer[position] != rune('d') {
-					goto l494
-				}
-				position++
-				if buffer[position] != rune('a') {
-					goto l494
+				position1072 := position
+				if buffer[position] != rune('o') {
+					goto l1071
 				}
 				position++
-				if buffer[position] != rune('y') {
-					goto l494
+				if buffer[position] != rune('n') {
+					goto l1071
 				}
 				position++
-				{
-					position496, tokenIndex496 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l496
-					}
-					position++
-					goto l497
-				l496:
-					position, tokenIndex = position496, tokenIndex496
-				}
-			l497:
 				if !_rules[rule_]() {
-					goto l494
+					goto l1071
 				}
-				add(ruleDAYS, position495)
+				add(ruleON, position1072)
 			}
 			return true
-		l494:
-			position, tokenIndex = position494, tokenIndex494
+		l1071:
+			position, tokenIndex = position1071, tokenIndex1071
 			return false
 		},
-		/* 27 HOURS <- <('h' 'o' 'u' 'r' 's'? _)> */
+		/* 91 AFTER <- <('a' 'f' 't' 'e' 'r' _)> */
 		func() bool {
-			position498, tokenIndex498 := position, tokenIndex
+			position1073, tokenIndex1073 := position, tokenIndex
 			{
-				position499 := position
-				if buffer[position] != rune('h') {
-					goto l498
+				position1074 := position
+				if buffer[position] != rune('a') {
+					goto l1073
 				}
 				position++
-				if buffer[position] != rune('o') {
-					goto l498
+				if buffer[position] != rune('f') {
+					goto l1073
 				}
 				position++
-				if buffer[position] != rune('u') {
-					goto l498
+				if buffer[position] != rune('t') {
+					goto l1073
 				}
 				position++
-				if buffer[position] != rune('r') {
-					goto l498
+				if buffer[position] != rune('e') {
+					goto l1073
 				}
 				position++
-				{
-					position500, tokenIndex500 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l500
-					}
-					position++
-					goto l501
-				l500:
-					position, tokenIndex = position500, tokenIndex500
+				if buffer[position] != rune('r') {
+					goto l1073
 				}
-			l501:
+				position++
 				if !_rules[rule_]() {
-					goto l498
+					goto l1073
 				}
-				add(ruleHOURS, position499)
+				add(ruleAFTER, position1074)
 			}
 			return true
-		l498:
-			position, tokenIndex = position498, tokenIndex498
+		l1073:
+			position, tokenIndex = position1073, tokenIndex1073
 			return false
 		},
-		/* 28 MINUTES <- <('m' 'i' 'n' 'u' 't' 'e' 's'? _)> */
+		/* 92 BEFORE <- <('b' 'e' 'f' 'o' 'r' 'e' _)> */
+		nil,
+		/* 93 START <- <((('s' 't' 'a' 'r' 't') / ('b' 'e' 'g' 'i' 'n' 'n' 'i' 'n' 'g')) _ ('o' 'f') _)> */
+		nil,
+		/* 94 END <- <('e' 'n' 'd' _ ('o' 'f') _)> */
+		nil,
+		/* 95 EOD <- <('e' 'o' 'd' ![a-z] _)> */
+		nil,
+		/* 96 EVERY <- <('e' 'v' 'e' 'r' 'y' _)> */
+		nil,
+		/* 97 OTHER <- <('o' 't' 'h' 'e' 'r' _)> */
+		nil,
+		/* 98 WEEKEND <- <('w' 'e' 'e' 'k' 'e' 'n' 'd' 's'? _)> */
 		func() bool {
-			position502, tokenIndex502 := position, tokenIndex
+			position1081, tokenIndex1081 := position, tokenIndex
 			{
-				position503 := position
-				if buffer[position] != rune('m') {
-					goto l502
+				position1082 := position
+				if buffer[position] != rune('w') {
+					goto l1081
 				}
 				position++
-				if buffer[position] != rune('i') {
-					goto l502
+				if buffer[position] != rune('e') {
+					goto l1081
 				}
 				position++
-				if buffer[position] != rune('n') {
-					goto l502
+				if buffer[position] != rune('e') {
+					goto l1081
 				}
 				position++
-				if buffer[position] != rune('u') {
-					goto l502
+				if buffer[position] != rune('k') {
+					goto l1081
 				}
 				position++
-				if buffer[position] != rune('t') {
-					goto l502
+				if buffer[position] != rune('e') {
+					goto l1081
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l502
+				if buffer[position] != rune('n') {
+					goto l1081
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l1081
 				}
 				position++
 				{
-					position504, tokenIndex504 := position, tokenIndex
+					position1083, tokenIndex1083 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l504
+						goto l1083
 					}
 					position++
-					goto l505
-				l504:
-					position, tokenIndex = position504, tokenIndex504
+					goto l1084
+				l1083:
+					position, tokenIndex = position1083, tokenIndex1083
 				}
-			l505:
+			l1084:
 				if !_rules[rule_]() {
-					goto l502
+					goto l1081
 				}
-				add(ruleMINUTES, position503)
+				add(ruleWEEKEND, position1082)
 			}
 			return true
-		l502:
-			position, tokenIndex = position502, tokenIndex502
+		l1081:
+			position, tokenIndex = position1081, tokenIndex1081
 			return false
 		},
-		/* 29 YESTERDAY <- <('y' 'e' 's' 't' 'e' 'r' 'd' 'a' 'y' _)> */
-		nil,
-		/* 30 TOMORROW <- <('t' 'o' 'm' 'o' 'r' 'r' 'o' 'w' _)> */
-		nil,
-		/* 31 TODAY <- <('t' 'o' 'd' 'a' 'y' _)> */
-		nil,
-		/* 32 AGO <- <('a' 'g' 'o' _)> */
+		/* 99 AT <- <('a' 't' _)> */
 		func() bool {
-			position509, tokenIndex509 := position, tokenIndex
+			position1085, tokenIndex1085 := position, tokenIndex
 			{
-				position510 := position
+				position1086 := position
 				if buffer[position] != rune('a') {
-					goto l509
-				}
-				position++
-				if buffer[position] != rune('g') {
-					goto l509
+					goto l1085
 				}
 				position++
-				if buffer[position] != rune('o') {
-					goto l509
+				if buffer[position] != rune('t') {
+					goto l1085
 				}
 				position++
 				if !_rules[rule_]() {
-					goto l509
+					goto l1085
 				}
-				add(ruleAGO, position510)
+				add(ruleAT, position1086)
 			}
 			return true
-		l509:
-			position, tokenIndex = position509, tokenIndex509
+		l1085:
+			position, tokenIndex = position1085, tokenIndex1085
 			return false
 		},
-		/* 33 FROM_NOW <- <('f' 'r' 'o' 'm' ' ' 'n' 'o' 'w' _)> */
+		/* 100 FOR <- <('f' 'o' 'r' _)> */
 		func() bool {
-			position511, tokenIndex511 := position, tokenIndex
+			position1087, tokenIndex1087 := position, tokenIndex
 			{
-				position512 := position
+				position1088 := position
 				if buffer[position] != rune('f') {
-					goto l511
-				}
-				position++
-				if buffer[position] != rune('r') {
-					goto l511
+					goto l1087
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l511
+					goto l1087
 				}
 				position++
-				if buffer[position] != rune('m') {
-					goto l511
+				if buffer[position] != rune('r') {
+					goto l1087
 				}
 				position++
-				if buffer[position] != rune(' ') {
-					goto l511
+				if !_rules[rule_]() {
+					goto l1087
+				}
+				add(ruleFOR, position1088)
+			}
+			return true
+		l1087:
+			position, tokenIndex = position1087, tokenIndex1087
+			return false
+		},
+		/* 101 FROM <- <('f' 'r' 'o' 'm' _)> */
+		func() bool {
+			position1089, tokenIndex1089 := position, tokenIndex
+			{
+				position1090 := position
+				if buffer[position] != rune('f') {
+					goto l1089
 				}
 				position++
-				if buffer[position] != rune('n') {
-					goto l511
+				if buffer[position] != rune('r') {
+					goto l1089
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l511
+					goto l1089
 				}
 				position++
-				if buffer[position] != rune('w') {
-					goto l511
+				if buffer[position] != rune('m') {
+					goto l1089
 				}
 				position++
 				if !_rules[rule_]() {
-					goto l511
+					goto l1089
 				}
-				add(ruleFROM_NOW, position512)
+				add(ruleFROM, position1090)
 			}
 			return true
-		l511:
-			position, tokenIndex = position511, tokenIndex511
+		l1089:
+			position, tokenIndex = position1089, tokenIndex1089
 			return false
 		},
-		/* 34 NOW <- <('n' 'o' 'w' _)> */
-		nil,
-		/* 35 AM <- <('a' 'm' _)> */
-		nil,
-		/* 36 PM <- <('p' 'm' _)> */
-		nil,
-		/* 37 NEXT <- <('n' 'e' 'x' 't' _)> */
+		/* 102 BETWEEN <- <('b' 'e' 't' 'w' 'e' 'e' 'n' _)> */
 		func() bool {
-			position516, tokenIndex516 := position, tokenIndex
+			position1091, tokenIndex1091 := position, tokenIndex
 			{
-				position517 := position
-				if buffer[position] != rune('n') {
-					goto l516
+				position1092 := position
+				if buffer[position] != rune('b') {
+					goto l1091
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l516
+					goto l1091
 				}
 				position++
-				if buffer[position] != rune('x') {
-					goto l516
+				if buffer[position] != rune('t') {
+					goto l1091
 				}
 				position++
-				if buffer[position] != rune('t') {
-					goto l516
+				if buffer[position] != rune('w') {
+					goto l1091
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1091
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1091
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l1091
 				}
 				position++
 				if !_rules[rule_]() {
-					goto l516
+					goto l1091
 				}
-				add(ruleNEXT, position517)
+				add(ruleBETWEEN, position1092)
 			}
 			return true
-		l516:
-			position, tokenIndex = position516, tokenIndex516
+		l1091:
+			position, tokenIndex = position1091, tokenIndex1091
 			return false
 		},
-		/* 38 IN <- <((('i' 'n' ' ' 'a' 'n') / ('i' 'n' ' ' 'a') / ('i' 'n')) _)> */
-		nil,
-		/* 39 LAST <- <((('l' 'a' 's' 't') / ('p' 'a' 's' 't') / ('p' 'r' 'e' 'v' 'i' 'o' 'u' 's')) _)> */
+		/* 103 TO <- <((('t' 'o') / ('t' 'h' 'r' 'o' 'u' 'g' 'h')) _)> */
 		func() bool {
-			position519, tokenIndex519 := position, tokenIndex
+			position1093, tokenIndex1093 := position, tokenIndex
 			{
-				position520 := position
+				position1094 := position
 				{
-					position521, tokenIndex521 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l522
+					position1095, tokenIndex1095 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1096
 					}
 					position++
-					if buffer[position] != rune('a') {
-						goto l522
+					if buffer[position] != rune('o') {
+						goto l1096
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l522
+					goto l1095
+				l1096:
+					position, tokenIndex = position1095, tokenIndex1095
+					if buffer[position] != rune('t') {
+						goto l1093
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l522
+					if buffer[position] != rune('h') {
+						goto l1093
 					}
 					position++
-					goto l521
-				l522:
-					position, tokenIndex = position521, tokenIndex521
-					if buffer[position] != rune('p') {
-						goto l523
+					if buffer[position] != rune('r') {
+						goto l1093
 					}
 					position++
-					if buffer[position] != rune('a') {
-						goto l523
+					if buffer[position] != rune('o') {
+						goto l1093
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l523
+					if buffer[position] != rune('u') {
+						goto l1093
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l523
+					if buffer[position] != rune('g') {
+						goto l1093
 					}
 					position++
-					goto l521
-				l523:
-					position, tokenIndex = position521, tokenIndex521
-					if buffer[position] != rune('p') {
-						goto l519
+					if buffer[position] != rune('h') {
+						goto l1093
 					}
 					position++
-					if buffer[position] != rune('r') {
-						goto l519
+				}
+			l1095:
+				if !_rules[rule_]() {
+					goto l1093
+				}
+				add(ruleTO, position1094)
+			}
+			return true
+		l1093:
+			position, tokenIndex = position1093, tokenIndex1093
+			return false
+		},
+		/* 104 PAST <- <('p' 'a' 's' 't' _)> */
+		nil,
+		/* 105 TIMES <- <((('t' 'i' 'm' 'e' 's') / ('o' 'c' 'c' 'u' 'r' 'r' 'e' 'n' 'c' 'e' 's')) _)> */
+		nil,
+		/* 106 EXCEPT <- <((('e' 'x' 'c' 'e' 'p' 't') / ('e' 'x' 'c' 'l' 'u' 'd' 'i' 'n' 'g') / ('b' 'u' 't' ' ' 'n' 'o' 't')) _)> */
+		nil,
+		/* 107 UNTIL <- <((('u' 'n' 't' 'i' 'l') / ('t' 'i' 'l' 'l') / ('t' 'h' 'r' 'o' 'u' 'g' 'h')) _)> */
+		func() bool {
+			position1100, tokenIndex1100 := position, tokenIndex
+			{
+				position1101 := position
+				{
+					position1102, tokenIndex1102 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l1103
 					}
 					position++
-					if buffer[position] != rune('e') {
-						goto l519
+					if buffer[position] != rune('n') {
+						goto l1103
 					}
 					position++
-					if buffer[position] != rune('v') {
-						goto l519
+					if buffer[position] != rune('t') {
+						goto l1103
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1103
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l1103
+					}
+					position++
+					goto l1102
+				l1103:
+					position, tokenIndex = position1102, tokenIndex1102
+					if buffer[position] != rune('t') {
+						goto l1104
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l519
+						goto l1104
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l1104
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l1104
+					}
+					position++
+					goto l1102
+				l1104:
+					position, tokenIndex = position1102, tokenIndex1102
+					if buffer[position] != rune('t') {
+						goto l1100
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l1100
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l1100
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l519
+						goto l1100
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l519
+						goto l1100
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l519
+					if buffer[position] != rune('g') {
+						goto l1100
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l1100
 					}
 					position++
 				}
-			l521:
+			l1102:
+				if !_rules[rule_]() {
+					goto l1100
+				}
+				add(ruleUNTIL, position1101)
+			}
+			return true
+		l1100:
+			position, tokenIndex = position1100, tokenIndex1100
+			return false
+		},
+		/* 108 AND <- <('a' 'n' 'd' _)> */
+		func() bool {
+			position1105, tokenIndex1105 := position, tokenIndex
+			{
+				position1106 := position
+				if buffer[position] != rune('a') {
+					goto l1105
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l1105
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l1105
+				}
+				position++
 				if !_rules[rule_]() {
-					goto l519
+					goto l1105
 				}
-				add(ruleLAST, position520)
+				add(ruleAND, position1106)
 			}
 			return true
-		l519:
-			position, tokenIndex = position519, tokenIndex519
+		l1105:
+			position, tokenIndex = position1105, tokenIndex1105
 			return false
 		},
-		/* 40 _ <- <Whitespace*> */
+		/* 109 NOON <- <((('n' 'o' 'o' 'n') / ('m' 'i' 'd' 'd' 'a' 'y')) _)> */
+		nil,
+		/* 110 MIDNIGHT <- <('m' 'i' 'd' 'n' 'i' 'g' 'h' 't' _)> */
+		nil,
+		/* 111 COB <- <((('c' 'o' 'b' ![a-z]) / ('c' 'l' 'o' 's' 'e' ' ' 'o' 'f' ' ' 'b' 'u' 's' 'i' 'n' 'e' 's' 's')) _)> */
+		nil,
+		/* 112 _ <- <Whitespace*> */
 		func() bool {
 			{
-				position525 := position
-			l526:
+				position1111 := position
+			l1112:
 				{
-					position527, tokenIndex527 := position, tokenIndex
-					{
-						position528 := position
+					position1113, tokenIndex1113 := position, tokenIndex
+					if !_rules[ruleWhitespace]() {
+						goto l1113
+					}
+					goto l1112
+				l1113:
+					position, tokenIndex = position1113, tokenIndex1113
+				}
+				add(rule_, position1111)
+			}
+			return true
+		},
+		/* 113 Whitespace <- <((&('\t') '\t') | (&(' ') ' ') | (&('\n' | '\r') EOL))> */
+		func() bool {
+			position1114, tokenIndex1114 := position, tokenIndex
+			{
+				position1115 := position
+				{
+					switch buffer[position] {
+					case '\t':
+						if buffer[position] != rune('\t') {
+							goto l1114
+						}
+						position++
+					case ' ':
+						if buffer[position] != rune(' ') {
+							goto l1114
+						}
+						position++
+					default:
 						{
-							switch buffer[position] {
-							case '\t':
-								if buffer[position] != rune('\t') {
-									goto l527
+							position1117 := position
+							{
+								position1118, tokenIndex1118 := position, tokenIndex
+								if buffer[position] != rune('\r') {
+									goto l1119
 								}
 								position++
-								break
-							case ' ':
-								if buffer[position] != rune(' ') {
-									goto l527
+								if buffer[position] != rune('\n') {
+									goto l1119
 								}
 								position++
-								break
-							default:
-								{
-									position530 := position
-									{
-										position531, tokenIndex531 := position, tokenIndex
-										if buffer[position] != rune('\r') {
-											goto l532
-										}
-										position++
-										if buffer[position] != rune('\n') {
-											goto l532
-										}
-										position++
-										goto l531
-									l532:
-										position, tokenIndex = position531, tokenIndex531
-										if buffer[position] != rune('\n') {
-											goto l533
-										}
-										position++
-										goto l531
-									l533:
-										position, tokenIndex = position531, tokenIndex531
-										if buffer[position] != rune('\r') {
-											goto l527
-										}
-										position++
-									}
-								l531:
-									add(ruleEOL, position530)
+								goto l1118
+							l1119:
+								position, tokenIndex = position1118, tokenIndex1118
+								if buffer[position] != rune('\n') {
+									goto l1120
+								}
+								position++
+								goto l1118
+							l1120:
+								position, tokenIndex = position1118, tokenIndex1118
+								if buffer[position] != rune('\r') {
+									goto l1114
 								}
-								break
+								position++
 							}
+						l1118:
+							add(ruleEOL, position1117)
 						}
+					}
+				}
 
-						add(ruleWhitespace, position528)
+				add(ruleWhitespace, position1115)
+			}
+			return true
+		l1114:
+			position, tokenIndex = position1114, tokenIndex1114
+			return false
+		},
+		/* 114 EOL <- <(('\r' '\n') / '\n' / '\r')> */
+		nil,
+		/* 115 EOF <- <!.> */
+		func() bool {
+			position1122, tokenIndex1122 := position, tokenIndex
+			{
+				position1123 := position
+				{
+					position1124, tokenIndex1124 := position, tokenIndex
+					if !matchDot() {
+						goto l1124
 					}
-					goto l526
-				l527:
-					position, tokenIndex = position527, tokenIndex527
+					goto l1122
+				l1124:
+					position, tokenIndex = position1124, tokenIndex1124
 				}
-				add(rule_, position525)
+				add(ruleEOF, position1123)
 			}
 			return true
+		l1122:
+			position, tokenIndex = position1122, tokenIndex1122
+			return false
 		},
-		/* 41 Whitespace <- <((&('\t') '\t') | (&(' ') ' ') | (&('\n' | '\r') EOL))> */
+		/* 117 Action0 <- <{ p.duration = 0 }> */
+		nil,
+		/* 118 Action1 <- <{ p.duration += time.Duration(p.number) * time.Minute }> */
+		nil,
+		/* 119 Action2 <- <{ p.duration += time.Duration(p.number) * time.Hour }> */
+		nil,
+		/* 120 Action3 <- <{ p.duration += time.Duration(p.number) * day }> */
+		nil,
+		/* 121 Action4 <- <{ p.duration += time.Duration(p.number) * week }> */
+		nil,
+		/* 122 Action5 <- <{ p.setEventStart(false) }> */
+		nil,
+		/* 123 Action6 <- <{ p.setEventStart(true) }> */
+		nil,
+		/* 124 Action7 <- <{ p.setEventEnd() }> */
+		nil,
+		nil,
+		/* 126 Action8 <- <{ p.addWord(begin, end) }> */
+		nil,
+		/* 127 Action9 <- <{ p.addWord(begin, end) }> */
+		nil,
+		/* 128 Action10 <- <{ p.setUntil() }> */
+		nil,
+		/* 129 Action11 <- <{ p.setUntilMonth() }> */
+		nil,
+		/* 130 Action12 <- <{ p.setCount(p.number) }> */
+		nil,
+		/* 131 Action13 <- <{ p.addTime() }> */
+		nil,
+		/* 132 Action14 <- <{ p.addTime() }> */
+		nil,
+		/* 133 Action15 <- <{ p.hour = p.t.Hour() }> */
+		nil,
+		/* 134 Action16 <- <{ p.setHourRange(p.hour) }> */
+		nil,
+		/* 135 Action17 <- <{
+		   for _, d := range p.businessWeekdays() {
+		     p.recurrence.addWeekday(d)
+		   }
+		 }> */
+		nil,
+		/* 136 Action18 <- <{
+		   for _, d := range p.weekend {
+		     p.recurrence.addWeekday(d)
+		   }
+		 }> */
+		nil,
+		/* 137 Action19 <- <{ p.addMinute(p.number) }> */
+		nil,
+		/* 138 Action20 <- <{ p.addMinute(p.number) }> */
+		nil,
+		/* 139 Action21 <- <{ p.recurrence.addMonth(p.month) }> */
+		nil,
+		/* 140 Action22 <- <{ p.recurrence.addMonth(p.month) }> */
+		nil,
+		/* 141 Action23 <- <{
+		   for _, d := range p.weekend {
+		     p.excludeWeekday(d)
+		   }
+		 }> */
+		nil,
+		/* 142 Action24 <- <{ p.excludeWeekday(p.weekday) }> */
+		nil,
+		/* 143 Action25 <- <{ p.excludeMonth(p.month) }> */
+		nil,
+		/* 144 Action26 <- <{
+		   p.recurrence.Frequency = Weekly
+		   p.recurrence.Weekdays = p.businessWeekdays()
+		 }> */
+		nil,
+		/* 145 Action27 <- <{
+		   p.recurrence.Frequency = Weekly
+		   p.recurrence.Weekdays = append([]time.Weekday(nil), p.weekend...)
+		 }> */
+		nil,
+		/* 146 Action28 <- <{
+		   p.recurrence.Frequency = Weekly
+		 }> */
+		nil,
+		/* 147 Action29 <- <{ p.setInterval(2) }> */
+		nil,
+		/* 148 Action30 <- <{ p.setInterval(p.number) }> */
+		nil,
+		/* 149 Action31 <- <{ p.recurrence.Frequency = Minutely }> */
+		nil,
+		/* 150 Action32 <- <{ p.recurrence.Frequency = Hourly }> */
+		nil,
+		/* 151 Action33 <- <{ p.recurrence.Frequency = Daily }> */
+		nil,
+		/* 152 Action34 <- <{ p.recurrence.Frequency = Weekly }> */
+		nil,
+		/* 153 Action35 <- <{ p.recurrence.Frequency = Monthly }> */
+		nil,
+		/* 154 Action36 <- <{ p.recurrence.Frequency = Yearly }> */
+		nil,
+		/* 155 Action37 <- <{ p.recurrence.Frequency = Hourly }> */
+		nil,
+		/* 156 Action38 <- <{ p.recurrence.Frequency = Daily }> */
+		nil,
+		/* 157 Action39 <- <{ p.recurrence.Frequency = Weekly }> */
+		nil,
+		/* 158 Action40 <- <{ p.recurrence.Frequency = Monthly }> */
+		nil,
+		/* 159 Action41 <- <{ p.recurrence.Frequency = Yearly }> */
+		nil,
+		/* 160 Action42 <- <{ p.recurrence.addWeekday(p.weekday) }> */
+		nil,
+		/* 161 Action43 <- <{ p.recurrence.addWeekday(p.weekday) }> */
+		nil,
+		/* 162 Action44 <- <{ p.setMonthDay(p.number) }> */
+		nil,
+		/* 163 Action45 <- <{
+		   p.t = startOf(p.t, p.period, p.weekStart)
+		 }> */
+		nil,
+		/* 164 Action46 <- <{
+		   p.t = endOf(p.t, p.period, p.weekStart)
+		 }> */
+		nil,
+		/* 165 Action47 <- <{
+		   p.period = periodDay
+		   p.t = endOf(p.t, p.period, p.weekStart)
+		 }> */
+		nil,
+		/* 166 Action48 <- <{
+		   p.setHour(17)
+		 }> */
+		nil,
+		/* 167 Action49 <- <{
+		   p.t = addPeriod(p.t, p.period, -1)
+		 }> */
+		nil,
+		/* 168 Action50 <- <{
+		   p.t = addPeriod(p.t, p.period, 1)
+		 }> */
+		nil,
+		/* 169 Action51 <- <{
+		   p.period = periodDay
+		 }> */
+		nil,
+		/* 170 Action52 <- <{
+		   p.period = periodDay
+		   p.t = p.t.Add(-day)
+		 }> */
+		nil,
+		/* 171 Action53 <- <{
+		   p.period = periodDay
+		   p.t = p.t.Add(day)
+		 }> */
+		nil,
+		/* 172 Action54 <- <{ p.period = periodMinute }> */
+		nil,
+		/* 173 Action55 <- <{ p.period = periodHour }> */
+		nil,
+		/* 174 Action56 <- <{ p.period = periodBusinessDay }> */
 		nil,
-		/* 42 EOL <- <(('\r' '\n') / '\n' / '\r')> */
+		/* 175 Action57 <- <{ p.period = periodDay }> */
 		nil,
-		/* 43 EOF <- <!.> */
+		/* 176 Action58 <- <{ p.period = periodWeek }> */
 		nil,
-		/* 45 Action0 <- <{
+		/* 177 Action59 <- <{ p.period = periodMonth }> */
+		nil,
+		/* 178 Action60 <- <{ p.period = periodQuarter }> */
+		nil,
+		/* 179 Action61 <- <{ p.period = periodYear }> */
+		nil,
+		/* 180 Action62 <- <{
+		   p.setHoliday(p.holiday, p.holidayYear(p.holiday, -1, true))
+		 }> */
+		nil,
+		/* 181 Action63 <- <{
+		   p.setHoliday(p.holiday, p.holidayYear(p.holiday, 1, true))
+		 }> */
+		nil,
+		/* 182 Action64 <- <{
+		   p.setHoliday(p.holiday, p.t.Year())
+		 }> */
+		nil,
+		/* 183 Action65 <- <{
+		   p.setHoliday(p.holiday, p.year)
+		 }> */
+		nil,
+		/* 184 Action66 <- <{
+		   p.setHoliday(p.holiday, p.holidayYear(p.holiday, p.direction, false))
+		 }> */
+		nil,
+		/* 185 Action67 <- <{
+		   n, _ := strconv.Atoi(text)
+		   p.setISOWeek(p.year, p.week)
+		   p.t = p.t.AddDate(0, 0, n-1)
+		   p.period = periodDay
+		 }> */
+		nil,
+		/* 186 Action68 <- <{
+		   p.setISOWeek(p.year, p.week)
+		 }> */
+		nil,
+		/* 187 Action69 <- <{
+		   p.setISOWeek(p.year, p.week)
+		 }> */
+		nil,
+		/* 188 Action70 <- <{
+		   year, _ := p.t.ISOWeek()
+		   p.setISOWeek(year, p.week)
+		 }> */
+		nil,
+		/* 189 Action71 <- <{
+		   p.later = append(p.later, func() {
+		     p.period = periodISOWeek
+		     p.t = startOf(p.t, p.period, p.weekStart)
+		   })
+		 }> */
+		nil,
+		/* 190 Action72 <- <{
+		   n, weekday := p.number, p.weekday
+		   p.t = p.truncateDay(p.t)
+		   p.later = append(p.later, func() {
+		     p.setNthWeekday(n, weekday)
+		   })
+		 }> */
+		nil,
+		/* 191 Action73 <- <{
+		   n := p.number
+		   p.t = p.truncateDay(p.t)
+		   p.later = append(p.later, func() {
+		     p.period = periodDay
+		     p.t = p.nthBusinessDay(p.t, n)
+		   })
+		 }> */
+		nil,
+		/* 192 Action74 <- <{
+		   n := p.number
+		   p.t = p.truncateDay(p.t)
+		   p.later = append(p.later, func() {
+		     p.period = periodDay
+		     p.t = nthDay(p.t, n)
+		   })
+		 }> */
+		nil,
+		/* 193 Action75 <- <{
 		   p.t = p.t.Add(-time.Minute * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 46 Action1 <- <{
+		/* 194 Action76 <- <{
 		   p.t = p.t.Add(time.Minute * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 47 Action2 <- <{
+		/* 195 Action77 <- <{
 		   p.t = p.t.Add(-time.Minute * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 48 Action3 <- <{
+		/* 196 Action78 <- <{
 		   p.t = p.t.Add(time.Minute * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 49 Action4 <- <{
+		/* 197 Action79 <- <{
+		   p.period = periodMinute
+		 }> */
+		nil,
+		/* 198 Action80 <- <{
 		   p.t = p.t.Add(p.withDirection(time.Minute) * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 50 Action5 <- <{
+		/* 199 Action81 <- <{
 		   p.t = p.t.Add(-time.Hour * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 51 Action6 <- <{
+		/* 200 Action82 <- <{
 		   p.t = p.t.Add(time.Hour * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 52 Action7 <- <{
+		/* 201 Action83 <- <{
 		   p.t = p.t.Add(-time.Hour * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 53 Action8 <- <{
+		/* 202 Action84 <- <{
 		   p.t = p.t.Add(time.Hour * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 54 Action9 <- <{
+		/* 203 Action85 <- <{
+		   p.period = periodHour
+		 }> */
+		nil,
+		/* 204 Action86 <- <{
 		   p.t = p.t.Add(p.withDirection(time.Hour) * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 55 Action10 <- <{
-		   p.t = truncateDay(p.t.Add(-day * time.Duration(p.number)))
+		/* 205 Action87 <- <{
+		   p.t = p.truncateDay(p.t.Add(-day * time.Duration(p.number)))
 		 }> */
 		nil,
-		/* 56 Action11 <- <{
+		/* 206 Action88 <- <{
 		   p.t = p.t.Add(day * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 57 Action12 <- <{
-		   p.t = truncateDay(p.t.Add(-day * time.Duration(p.number)))
+		/* 207 Action89 <- <{
+		   p.t = p.truncateDay(p.t.Add(-day * time.Duration(p.number)))
+		 }> */
+		nil,
+		/* 208 Action90 <- <{
+		   p.t = p.truncateDay(p.t.Add(day * time.Duration(p.number)))
+		 }> */
+		nil,
+		/* 209 Action91 <- <{
+		   p.period = periodDay
+		 }> */
+		nil,
+		/* 210 Action92 <- <{
+		   p.t = p.truncateDay(p.t.Add(p.withDirection(day) * time.Duration(p.number)))
+		 }> */
+		nil,
+		/* 211 Action93 <- <{
+		   n, unit := p.number, p.period
+		   p.period = periodNone
+		   p.later = append(p.later, func() {
+		     p.addPeriod(unit, n)
+		   })
+		 }> */
+		nil,
+		/* 212 Action94 <- <{
+		   n, unit := p.number, p.period
+		   p.period = periodNone
+		   p.later = append(p.later, func() {
+		     p.addPeriod(unit, -n)
+		   })
+		 }> */
+		nil,
+		/* 213 Action95 <- <{
+		   p.period = periodDay
+		   p.t = p.truncateDay(thisWeekday(p.t, p.weekday, p.weekStart))
+		 }> */
+		nil,
+		/* 214 Action96 <- <{
+		   p.period = periodDay
+		   p.t = p.truncateDay(thisWeekday(p.t, p.weekday, p.weekStart))
+		 }> */
+		nil,
+		/* 215 Action97 <- <{
+		   p.period = periodDay
+		   p.t = p.truncateDay(nextWeekday(p.t, p.weekday).Add(week))
+		 }> */
+		nil,
+		/* 216 Action98 <- <{ p.t = p.t.Add(-week) }> */
+		nil,
+		/* 217 Action99 <- <{ p.t = p.t.Add(week) }> */
+		nil,
+		/* 218 Action100 <- <{
+		   p.t = p.truncateDay(p.addBusinessDays(p.t, -p.number))
+		 }> */
+		nil,
+		/* 219 Action101 <- <{
+		   p.t = p.addBusinessDays(p.t, p.number)
+		 }> */
+		nil,
+		/* 220 Action102 <- <{
+		   p.t = p.truncateDay(p.addBusinessDays(p.t, -p.number))
+		 }> */
+		nil,
+		/* 221 Action103 <- <{
+		   p.t = p.truncateDay(p.addBusinessDays(p.t, p.number))
+		 }> */
+		nil,
+		/* 222 Action104 <- <{
+		   p.t = p.truncateDay(p.addBusinessDays(p.t, p.direction * p.number))
+		 }> */
+		nil,
+		/* 223 Action105 <- <{
+		   p.t = p.addBusinessTime(p.t, -time.Minute * time.Duration(p.number))
+		 }> */
+		nil,
+		/* 224 Action106 <- <{
+		   p.t = p.addBusinessTime(p.t, time.Minute * time.Duration(p.number))
+		 }> */
+		nil,
+		/* 225 Action107 <- <{
+		   p.t = p.addBusinessTime(p.t, p.withDirection(time.Minute) * time.Duration(p.number))
+		 }> */
+		nil,
+		/* 226 Action108 <- <{
+		   p.t = p.addBusinessTime(p.t, -time.Hour * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 58 Action13 <- <{
-		   p.t = truncateDay(p.t.Add(day * time.Duration(p.number)))
+		/* 227 Action109 <- <{
+		   p.t = p.addBusinessTime(p.t, time.Hour * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 59 Action14 <- <{
-		   p.t = truncateDay(p.t.Add(p.withDirection(day) * time.Duration(p.number)))
+		/* 228 Action110 <- <{
+		   p.t = p.addBusinessTime(p.t, p.withDirection(time.Hour) * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 60 Action15 <- <{
-		   p.t = truncateDay(p.t.Add(-week * time.Duration(p.number)))
+		/* 229 Action111 <- <{
+		   p.t = p.truncateDay(p.t.Add(-week * time.Duration(p.number)))
 		 }> */
 		nil,
-		/* 61 Action16 <- <{
+		/* 230 Action112 <- <{
 		   p.t = p.t.Add(week * time.Duration(p.number))
 		 }> */
 		nil,
-		/* 62 Action17 <- <{
-		   p.t = truncateDay(p.t.Add(-week * time.Duration(p.number)))
+		/* 231 Action113 <- <{
+		   p.period = periodWeek
+		   p.t = startOf(p.t.Add(-week), p.period, p.weekStart)
+		 }> */
+		nil,
+		/* 232 Action114 <- <{
+		   p.period = periodWeek
+		   p.t = startOf(p.t.Add(week), p.period, p.weekStart)
+		 }> */
+		nil,
+		/* 233 Action115 <- <{
+		   p.t = p.truncateDay(p.t.Add(-week * time.Duration(p.number)))
+		 }> */
+		nil,
+		/* 234 Action116 <- <{
+		   p.t = p.truncateDay(p.t.Add(week * time.Duration(p.number)))
+		 }> */
+		nil,
+		/* 235 Action117 <- <{
+		   p.period = periodWeek
 		 }> */
 		nil,
-		/* 63 Action18 <- <{
-		   p.t = truncateDay(p.t.Add(week * time.Duration(p.number)))
+		/* 236 Action118 <- <{
+		   p.t = p.truncateDay(p.t.Add(p.withDirection(week) * time.Duration(p.number)))
 		 }> */
 		nil,
-		/* 64 Action19 <- <{
-		   p.t = truncateDay(p.t.Add(p.withDirection(week) * time.Duration(p.number)))
+		/* 237 Action119 <- <{
+		   p.addMonths(-p.number)
 		 }> */
 		nil,
-		/* 65 Action20 <- <{
-		   p.t = p.t.AddDate(0, -p.number, 0)
+		/* 238 Action120 <- <{
+		   p.addMonths(p.number)
 		 }> */
 		nil,
-		/* 66 Action21 <- <{
-		   p.t = p.t.AddDate(0, p.number, 0)
+		/* 239 Action121 <- <{
+		   p.addMonths(-p.number)
 		 }> */
 		nil,
-		/* 67 Action22 <- <{
-		   p.t = p.t.AddDate(0, -p.number, 0)
+		/* 240 Action122 <- <{
+		   p.addMonths(p.number)
 		 }> */
 		nil,
-		/* 68 Action23 <- <{
-		   p.t = p.t.AddDate(0, p.number, 0)
+		/* 241 Action123 <- <{
+		   p.period = periodMonth
 		 }> */
 		nil,
-		/* 69 Action24 <- <{
+		/* 242 Action124 <- <{
 		   p.t = prevMonth(p.t, p.month)
 		 }> */
 		nil,
-		/* 70 Action25 <- <{
+		/* 243 Action125 <- <{
 		   p.t = nextMonth(p.t, p.month)
 		 }> */
 		nil,
-		/* 71 Action26 <- <{
+		/* 244 Action126 <- <{
+		   p.t = thisMonth(p.t, p.month)
+		 }> */
+		nil,
+		/* 245 Action127 <- <{
 		   if p.direction < 0 {
 		     p.t = prevMonth(p.t, p.month)
 		   } else {
@@ -5189,160 +13259,247 @@ func (p *parser) Init(options ...func(*parser) error) error {
 		   }
 		 }> */
 		nil,
-		/* 72 Action27 <- <{
-		   p.t = p.t.AddDate(-p.number, 0, 0)
+		/* 246 Action128 <- <{
+		   p.addMonths(-12 * p.number)
+		 }> */
+		nil,
+		/* 247 Action129 <- <{
+		   p.addMonths(12 * p.number)
 		 }> */
 		nil,
-		/* 73 Action28 <- <{
-		   p.t = p.t.AddDate(p.number, 0, 0)
+		/* 248 Action130 <- <{
+		   p.addMonths(-12 * p.number)
 		 }> */
 		nil,
-		/* 74 Action29 <- <{
-		   p.t = p.t.AddDate(-p.number, 0, 0)
+		/* 249 Action131 <- <{
+		   p.addMonths(12 * p.number)
 		 }> */
 		nil,
-		/* 75 Action30 <- <{
-		   p.t = p.t.AddDate(p.number, 0, 0)
+		/* 250 Action132 <- <{
+		   p.period = periodYear
 		 }> */
 		nil,
-		/* 76 Action31 <- <{
+		/* 251 Action133 <- <{
 		   p.t = time.Date(p.t.Year() - 1, 1, 1, 0, 0, 0, 0, p.t.Location())
 		 }> */
 		nil,
-		/* 77 Action32 <- <{
+		/* 252 Action134 <- <{
 		   p.t = time.Date(p.t.Year() + 1, 1, 1, 0, 0, 0, 0, p.t.Location())
 		 }> */
 		nil,
-		/* 78 Action33 <- <{
-		   p.t = truncateDay(p.t)
+		/* 253 Action135 <- <{
+		   p.t = p.truncateDay(p.t)
+		 }> */
+		nil,
+		/* 254 Action136 <- <{
+		   p.t = p.truncateDay(p.t.Add(-day))
+		 }> */
+		nil,
+		/* 255 Action137 <- <{
+		   p.t = p.truncateDay(p.t.Add(+day))
+		 }> */
+		nil,
+		/* 256 Action138 <- <{
+		   p.t = p.truncateDay(addWeekday(p.t, p.weekday, -p.number))
+		 }> */
+		nil,
+		/* 257 Action139 <- <{
+		   p.t = p.truncateDay(addWeekday(p.t, p.weekday, p.number))
 		 }> */
 		nil,
-		/* 79 Action34 <- <{
-		   p.t = truncateDay(p.t.Add(-day))
+		/* 258 Action140 <- <{
+		   p.t = p.truncateDay(addWeekday(p.t, p.weekday, p.direction * p.number))
 		 }> */
 		nil,
-		/* 80 Action35 <- <{
-		   p.t = truncateDay(p.t.Add(+day))
+		/* 259 Action141 <- <{
+		   p.t = p.truncateDay(prevWeekday(p.t, p.weekday))
 		 }> */
 		nil,
-		/* 81 Action36 <- <{
-		   p.t = truncateDay(prevWeekday(p.t, p.weekday))
+		/* 260 Action142 <- <{
+		   p.t = p.truncateDay(nextWeekday(p.t, p.weekday))
 		 }> */
 		nil,
-		/* 82 Action37 <- <{
-		   p.t = truncateDay(nextWeekday(p.t, p.weekday))
+		/* 261 Action143 <- <{
+		   p.t = p.truncateDay(thisWeekday(p.t, p.weekday, p.weekStart))
 		 }> */
 		nil,
-		/* 83 Action38 <- <{
+		/* 262 Action144 <- <{
 		   if p.direction < 0 {
-		     p.t = truncateDay(prevWeekday(p.t, p.weekday))
+		     p.t = p.truncateDay(prevWeekday(p.t, p.weekday))
 		   } else {
-		     p.t = truncateDay(nextWeekday(p.t, p.weekday))
+		     p.t = p.truncateDay(nextWeekday(p.t, p.weekday))
 		   }
 		 }> */
 		nil,
-		/* 84 Action39 <- <{
-		   t := p.t
-		   year, month, _ := t.Date()
-		   hour, min, sec := t.Clock()
-		   p.t = time.Date(year, month, p.number, hour, min, sec, 0, t.Location())
+		/* 263 Action145 <- <{
+		   p.setDay(p.number)
 		 }> */
 		nil,
-		/* 85 Action40 <- <{
-		   year, month, day := p.t.Date()
-		   p.t = time.Date(year, month, day, p.number, 0, 0, 0, p.t.Location())
+		/* 264 Action146 <- <{ p.setHour(12) }> */
+		nil,
+		/* 265 Action147 <- <{ p.setHour(0) }> */
+		nil,
+		/* 266 Action148 <- <{
+		   p.setHour12(p.number, false)
 		 }> */
 		nil,
-		/* 86 Action41 <- <{
-		   year, month, day := p.t.Date()
-		   p.t = time.Date(year, month, day, p.number + 12, 0, 0, 0, p.t.Location())
+		/* 267 Action149 <- <{
+		   p.setHour12(p.number, true)
 		 }> */
 		nil,
-		/* 87 Action42 <- <{
-		   year, month, day := p.t.Date()
-		   p.t = time.Date(year, month, day, p.number, 0, 0, 0, p.t.Location())
+		/* 268 Action150 <- <{
+		   p.setHour(p.number)
 		 }> */
 		nil,
-		/* 88 Action43 <- <{
-		   t := p.t
-		   year, month, day := t.Date()
-		   hour, _, _ := t.Clock()
-		   p.t = time.Date(year, month, day, hour, p.number, 0, 0, t.Location())
+		/* 269 Action151 <- <{
+		   p.setMinute(p.number)
 		 }> */
 		nil,
-		/* 89 Action44 <- <{
-		   t := p.t
-		   year, month, day := t.Date()
-		   hour, min, _ := t.Clock()
-		   p.t = time.Date(year, month, day, hour, min, p.number, 0, t.Location())
+		/* 270 Action152 <- <{
+		   p.setSecond(p.number)
 		 }> */
 		nil,
+		/* 271 Action153 <- <{ n, _ := strconv.Atoi(text); p.number = n }> */
+		nil,
+		/* 272 Action154 <- <{ p.number = 1 }> */
+		nil,
+		/* 273 Action155 <- <{ p.number = 2 }> */
+		nil,
+		/* 274 Action156 <- <{ p.number = 3 }> */
+		nil,
+		/* 275 Action157 <- <{ p.number = 4 }> */
+		nil,
+		/* 276 Action158 <- <{ p.number = 5 }> */
+		nil,
+		/* 277 Action159 <- <{ p.number = 6 }> */
+		nil,
+		/* 278 Action160 <- <{ p.number = 7 }> */
+		nil,
+		/* 279 Action161 <- <{ p.number = 8 }> */
+		nil,
+		/* 280 Action162 <- <{ p.number = 9 }> */
+		nil,
+		/* 281 Action163 <- <{ p.number = 10 }> */
+		nil,
+		/* 282 Action164 <- <{ n, _ := strconv.Atoi(text); p.year = n }> */
+		nil,
+		/* 283 Action165 <- <{ n, _ := strconv.Atoi(text); p.week = n }> */
+		nil,
+		/* 284 Action166 <- <{ p.holiday = "new year's eve" }> */
+		nil,
+		/* 285 Action167 <- <{ p.holiday = "new year's day" }> */
+		nil,
+		/* 286 Action168 <- <{ p.holiday = "mlk day" }> */
+		nil,
+		/* 287 Action169 <- <{ p.holiday = "valentine's day" }> */
+		nil,
+		/* 288 Action170 <- <{ p.holiday = "presidents day" }> */
+		nil,
+		/* 289 Action171 <- <{ p.holiday = "st patrick's day" }> */
+		nil,
+		/* 290 Action172 <- <{ p.holiday = "good friday" }> */
+		nil,
+		/* 291 Action173 <- <{ p.holiday = "easter monday" }> */
+		nil,
+		/* 292 Action174 <- <{ p.holiday = "easter" }> */
+		nil,
+		/* 293 Action175 <- <{ p.holiday = "mother's day" }> */
+		nil,
+		/* 294 Action176 <- <{ p.holiday = "memorial day" }> */
+		nil,
+		/* 295 Action177 <- <{ p.holiday = "father's day" }> */
+		nil,
+		/* 296 Action178 <- <{ p.holiday = "independence day" }> */
+		nil,
+		/* 297 Action179 <- <{ p.holiday = "labor day" }> */
+		nil,
+		/* 298 Action180 <- <{ p.holiday = "early may bank holiday" }> */
+		nil,
+		/* 299 Action181 <- <{ p.holiday = "spring bank holiday" }> */
+		nil,
+		/* 300 Action182 <- <{ p.holiday = "summer bank holiday" }> */
+		nil,
+		/* 301 Action183 <- <{ p.holiday = "may day" }> */
+		nil,
+		/* 302 Action184 <- <{ p.holiday = "ascension day" }> */
+		nil,
+		/* 303 Action185 <- <{ p.holiday = "whit monday" }> */
+		nil,
+		/* 304 Action186 <- <{ p.holiday = "german unity day" }> */
+		nil,
+		/* 305 Action187 <- <{ p.holiday = "columbus day" }> */
+		nil,
+		/* 306 Action188 <- <{ p.holiday = "halloween" }> */
+		nil,
+		/* 307 Action189 <- <{ p.holiday = "veterans day" }> */
+		nil,
+		/* 308 Action190 <- <{ p.holiday = "thanksgiving" }> */
 		nil,
-		/* 91 Action45 <- <{ n, _ := strconv.Atoi(text); p.number = n }> */
+		/* 309 Action191 <- <{ p.holiday = "christmas eve" }> */
 		nil,
-		/* 92 Action46 <- <{ p.number = 1 }> */
+		/* 310 Action192 <- <{ p.holiday = "christmas" }> */
 		nil,
-		/* 93 Action47 <- <{ p.number = 2 }> */
+		/* 311 Action193 <- <{ p.holiday = "boxing day" }> */
 		nil,
-		/* 94 Action48 <- <{ p.number = 3 }> */
+		/* 312 Action194 <- <{ p.number = 1 }> */
 		nil,
-		/* 95 Action49 <- <{ p.number = 4 }> */
+		/* 313 Action195 <- <{ p.number = 2 }> */
 		nil,
-		/* 96 Action50 <- <{ p.number = 5 }> */
+		/* 314 Action196 <- <{ p.number = 3 }> */
 		nil,
-		/* 97 Action51 <- <{ p.number = 6 }> */
+		/* 315 Action197 <- <{ p.number = 4 }> */
 		nil,
-		/* 98 Action52 <- <{ p.number = 7 }> */
+		/* 316 Action198 <- <{ p.number = 5 }> */
 		nil,
-		/* 99 Action53 <- <{ p.number = 8 }> */
+		/* 317 Action199 <- <{ p.number = -1 }> */
 		nil,
-		/* 100 Action54 <- <{ p.number = 9 }> */
+		/* 318 Action200 <- <{ p.weekday = time.Sunday }> */
 		nil,
-		/* 101 Action55 <- <{ p.number = 10 }> */
+		/* 319 Action201 <- <{ p.weekday = time.Monday }> */
 		nil,
-		/* 102 Action56 <- <{ p.weekday = time.Sunday }> */
+		/* 320 Action202 <- <{ p.weekday = time.Tuesday }> */
 		nil,
-		/* 103 Action57 <- <{ p.weekday = time.Monday }> */
+		/* 321 Action203 <- <{ p.weekday = time.Wednesday }> */
 		nil,
-		/* 104 Action58 <- <{ p.weekday = time.Tuesday }> */
+		/* 322 Action204 <- <{ p.weekday = time.Thursday }> */
 		nil,
-		/* 105 Action59 <- <{ p.weekday = time.Wednesday }> */
+		/* 323 Action205 <- <{ p.weekday = time.Friday }> */
 		nil,
-		/* 106 Action60 <- <{ p.weekday = time.Thursday }> */
+		/* 324 Action206 <- <{ p.weekday = time.Saturday }> */
 		nil,
-		/* 107 Action61 <- <{ p.weekday = time.Friday }> */
+		/* 325 Action207 <- <{ p.month = time.January }> */
 		nil,
-		/* 108 Action62 <- <{ p.weekday = time.Saturday }> */
+		/* 326 Action208 <- <{ p.month = time.February }> */
 		nil,
-		/* 109 Action63 <- <{ p.month = time.January }> */
+		/* 327 Action209 <- <{ p.month = time.March }> */
 		nil,
-		/* 110 Action64 <- <{ p.month = time.February }> */
+		/* 328 Action210 <- <{ p.month = time.April }> */
 		nil,
-		/* 111 Action65 <- <{ p.month = time.March }> */
+		/* 329 Action211 <- <{ p.month = time.May }> */
 		nil,
-		/* 112 Action66 <- <{ p.month = time.April }> */
+		/* 330 Action212 <- <{ p.month = time.June }> */
 		nil,
-		/* 113 Action67 <- <{ p.month = time.May }> */
+		/* 331 Action213 <- <{ p.month = time.July }> */
 		nil,
-		/* 114 Action68 <- <{ p.month = time.June }> */
+		/* 332 Action214 <- <{ p.month = time.August }> */
 		nil,
-		/* 115 Action69 <- <{ p.month = time.July }> */
+		/* 333 Action215 <- <{ p.month = time.September }> */
 		nil,
-		/* 116 Action70 <- <{ p.month = time.August }> */
+		/* 334 Action216 <- <{ p.month = time.October }> */
 		nil,
-		/* 117 Action71 <- <{ p.month = time.September }> */
+		/* 335 Action217 <- <{ p.month = time.November }> */
 		nil,
-		/* 118 Action72 <- <{ p.month = time.October }> */
+		/* 336 Action218 <- <{ p.month = time.December }> */
 		nil,
-		/* 119 Action73 <- <{ p.month = time.November }> */
+		/* 337 Action219 <- <{ p.number = 1}> */
 		nil,
-		/* 120 Action74 <- <{ p.month = time.December }> */
+		/* 338 Action220 <- <{ p.number = 1 }> */
 		nil,
-		/* 121 Action75 <- <{ p.number = 1}> */
+		/* 339 Action221 <- <{ p.number = 1 }> */
 		nil,
-		/* 122 Action76 <- <{ p.number = 1 }> */
+		/* 340 Action222 <- <{ p.number = 1 }> */
 		nil,
-		/* 123 Action77 <- <{ p.number = 1 }> */
+		/* 341 Action223 <- <{ p.addWord(begin, end) }> */
 		nil,
 	}
 	p.rules = _rules
@@ -0,0 +1,297 @@
+package naturaldate
+
+import "time"
+
+// Holiday is a holiday on a given date.
+type Holiday struct {
+	// Name is the name used in expressions, such as "christmas".
+	Name string
+
+	// Date is the day of the holiday.
+	Date time.Time
+}
+
+// HolidayCalendar is a calendar of observed holidays.
+type HolidayCalendar interface {
+	// Holidays returns the holidays observed in the given year.
+	Holidays(year int, loc *time.Location) []Holiday
+}
+
+// Holiday calendars available.
+var (
+	USHolidays HolidayCalendar = holidaySet{
+		observe: observeNearest,
+		suffix:  " (observed)",
+		names: []string{
+			"new year's day",
+			"mlk day",
+			"presidents day",
+			"memorial day",
+			"independence day",
+			"labor day",
+			"columbus day",
+			"veterans day",
+			"thanksgiving",
+			"christmas",
+		},
+	}
+
+	UKHolidays HolidayCalendar = holidaySet{
+		observe: observeFollowing,
+		suffix:  " (substitute day)",
+		names: []string{
+			"new year's day",
+			"good friday",
+			"easter monday",
+			"early may bank holiday",
+			"spring bank holiday",
+			"summer bank holiday",
+			"christmas",
+			"boxing day",
+		},
+	}
+
+	DEHolidays HolidayCalendar = holidaySet{
+		names: []string{
+			"new year's day",
+			"good friday",
+			"easter monday",
+			"may day",
+			"ascension day",
+			"whit monday",
+			"german unity day",
+			"christmas",
+			"boxing day",
+		},
+	}
+)
+
+// holidaySet is a calendar of built-in holidays by name. Holidays falling on
+// a weekend are also observed on the weekday chosen by observe, if any, named
+// with the suffix, such as "christmas (observed)".
+type holidaySet struct {
+	names   []string
+	observe observance
+	suffix  string
+}
+
+// observance returns the day a holiday on date t is observed, given the
+// days already taken by other holidays.
+type observance func(t time.Time, taken []time.Time) time.Time
+
+// Holidays implementation.
+func (s holidaySet) Holidays(year int, loc *time.Location) (v []Holiday) {
+	for _, name := range s.names {
+		v = append(v, Holiday{
+			Name: name,
+			Date: holidays[name](year, loc),
+		})
+	}
+
+	if s.observe == nil {
+		return
+	}
+
+	// holidays early in the following year may be observed in this one
+	for _, y := range []int{year, year + 1} {
+		var taken []time.Time
+		for _, name := range s.names {
+			taken = append(taken, holidays[name](y, loc))
+		}
+
+		for i, name := range s.names {
+			d := s.observe(taken[i], taken)
+			if d.Equal(taken[i]) {
+				continue
+			}
+
+			taken = append(taken, d)
+			if d.Year() == year {
+				v = append(v, Holiday{
+					Name: name + s.suffix,
+					Date: d,
+				})
+			}
+		}
+	}
+
+	return
+}
+
+// observeNearest observes holidays on a Saturday on the Friday before, and on
+// a Sunday on the Monday after, as in the US.
+func observeNearest(t time.Time, taken []time.Time) time.Time {
+	switch t.Weekday() {
+	case time.Saturday:
+		return t.AddDate(0, 0, -1)
+	case time.Sunday:
+		return t.AddDate(0, 0, 1)
+	}
+	return t
+}
+
+// observeFollowing observes holidays on a weekend on the next weekday which
+// is not already a holiday, as with substitute days in the UK.
+func observeFollowing(t time.Time, taken []time.Time) time.Time {
+	if t.Weekday() != time.Saturday && t.Weekday() != time.Sunday {
+		return t
+	}
+
+	d := t.AddDate(0, 0, 1)
+	for d.Weekday() == time.Saturday || d.Weekday() == time.Sunday || hasTime(taken, d) {
+		d = d.AddDate(0, 0, 1)
+	}
+	return d
+}
+
+// hasTime returns true if time t is in times.
+func hasTime(times []time.Time, t time.Time) bool {
+	for _, v := range times {
+		if v.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithHolidays sets the holiday calendars observed, which are skipped by
+// business day expressions and used to resolve holiday names such as
+// "christmas". Holidays missing from the calendars fall back to their usual
+// date.
+func WithHolidays(calendars ...HolidayCalendar) Option {
+	return func(p *parser) {
+		p.calendars = append(p.calendars, calendars...)
+	}
+}
+
+// holiday returns the date of a holiday in the given year.
+type holiday func(year int, loc *time.Location) time.Time
+
+// holidays available by name.
+var holidays = map[string]holiday{
+	"new year's day":         fixed(time.January, 1),
+	"mlk day":                nth(3, time.Monday, time.January),
+	"valentine's day":        fixed(time.February, 14),
+	"presidents day":         nth(3, time.Monday, time.February),
+	"st patrick's day":       fixed(time.March, 17),
+	"good friday":            easterOffset(-2),
+	"easter":                 easterOffset(0),
+	"easter monday":          easterOffset(1),
+	"mother's day":           nth(2, time.Sunday, time.May),
+	"memorial day":           nth(-1, time.Monday, time.May),
+	"father's day":           nth(3, time.Sunday, time.June),
+	"independence day":       fixed(time.July, 4),
+	"labor day":              nth(1, time.Monday, time.September),
+	"columbus day":           nth(2, time.Monday, time.October),
+	"halloween":              fixed(time.October, 31),
+	"veterans day":           fixed(time.November, 11),
+	"thanksgiving":           nth(4, time.Thursday, time.November),
+	"christmas eve":          fixed(time.December, 24),
+	"christmas":              fixed(time.December, 25),
+	"boxing day":             fixed(time.December, 26),
+	"new year's eve":         fixed(time.December, 31),
+	"early may bank holiday": nth(1, time.Monday, time.May),
+	"spring bank holiday":    nth(-1, time.Monday, time.May),
+	"summer bank holiday":    nth(-1, time.Monday, time.August),
+	"may day":                fixed(time.May, 1),
+	"ascension day":          easterOffset(39),
+	"whit monday":            easterOffset(50),
+	"german unity day":       fixed(time.October, 3),
+}
+
+// fixed returns a holiday on the same date every year.
+func fixed(month time.Month, day int) holiday {
+	return func(year int, loc *time.Location) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	}
+}
+
+// nth returns a holiday on the nth week day of the month, counting from the
+// end of the month when n is negative.
+func nth(n int, day time.Weekday, month time.Month) holiday {
+	return func(year int, loc *time.Location) time.Time {
+		t, _ := nthWeekday(time.Date(year, month, 1, 0, 0, 0, 0, loc), n, day)
+		return t
+	}
+}
+
+// easterOffset returns a holiday n days from Easter Sunday.
+func easterOffset(n int) holiday {
+	return func(year int, loc *time.Location) time.Time {
+		return easter(year, loc).AddDate(0, 0, n)
+	}
+}
+
+// easter returns Easter Sunday of the given year, using the anonymous
+// Gregorian algorithm.
+func easter(year int, loc *time.Location) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+// holidayDate returns the date of the named holiday in the given year,
+// preferring the configured calendars.
+func (p *parser) holidayDate(name string, year int) time.Time {
+	for _, c := range p.calendars {
+		for _, h := range c.Holidays(year, p.t.Location()) {
+			if h.Name == name {
+				return truncateDay(h.Date)
+			}
+		}
+	}
+	return holidays[name](year, p.t.Location())
+}
+
+// isHoliday returns true if time t falls on a holiday in the configured
+// calendars.
+func (p *parser) isHoliday(t time.Time) bool {
+	y, m, d := t.Date()
+	for _, c := range p.calendars {
+		for _, h := range c.Holidays(y, t.Location()) {
+			hy, hm, hd := h.Date.Date()
+			if hy == y && hm == m && hd == d {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// setHoliday sets the date to the named holiday in the given year.
+func (p *parser) setHoliday(name string, year int) {
+	d := p.holidayDate(name, year)
+	if p.clock {
+		hour, min, sec := p.t.Clock()
+		d = time.Date(d.Year(), d.Month(), d.Day(), hour, min, sec, 0, d.Location())
+	}
+	p.period = periodDay
+	p.t = d
+}
+
+// holidayYear returns the year of the nearest named holiday in direction dir,
+// including today unless strict.
+func (p *parser) holidayYear(name string, dir int, strict bool) int {
+	today := truncateDay(p.t)
+	year := p.t.Year()
+	d := p.holidayDate(name, year)
+	switch {
+	case dir < 0 && (d.After(today) || strict && d.Equal(today)):
+		year--
+	case dir > 0 && (d.Before(today) || strict && d.Equal(today)):
+		year++
+	}
+	return year
+}
@@ -0,0 +1,127 @@
+package naturaldate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tj/assert"
+)
+
+// calendarCases are test cases for holiday calendars.
+var calendarCases = []struct {
+	Calendar HolidayCalendar
+	Input    string
+	Output   string
+}{
+	{USHolidays, `the first business day of january`, `2019-01-02 00:00:00 +0000 UTC`},
+	{USHolidays, `the first business day of september`, `2019-09-03 00:00:00 +0000 UTC`},
+	{USHolidays, `the last business day of may`, `2019-05-31 00:00:00 +0000 UTC`},
+	{USHolidays, `in 5 business days`, `2019-12-03 13:07:18 +0000 UTC`},
+	{USHolidays, `2 business days after christmas`, `2018-12-27 00:00:00 +0000 UTC`},
+	{UKHolidays, `the first business day of september`, `2019-09-02 00:00:00 +0000 UTC`},
+	{UKHolidays, `the last business day of august`, `2019-08-30 00:00:00 +0000 UTC`},
+	{UKHolidays, `the last business day of may`, `2019-05-31 00:00:00 +0000 UTC`},
+	{UKHolidays, `spring bank holiday`, `2019-05-27 00:00:00 +0000 UTC`},
+	{DEHolidays, `the first business day of october`, `2019-10-01 00:00:00 +0000 UTC`},
+	{DEHolidays, `whit monday`, `2019-06-10 00:00:00 +0000 UTC`},
+	{DEHolidays, `german unity day`, `2019-10-03 00:00:00 +0000 UTC`},
+	{DEHolidays, `christmas`, `2018-12-25 00:00:00 +0000 UTC`},
+	{USHolidays, `the first business day after christmas 2021`, `2021-12-27 00:00:00 +0000 UTC`},
+	{UKHolidays, `the first business day after christmas 2021`, `2021-12-29 00:00:00 +0000 UTC`},
+	{DEHolidays, `thanksgiving`, `2018-11-22 00:00:00 +0000 UTC`},
+}
+
+// Test easter.
+func TestEaster(t *testing.T) {
+	cases := map[int]string{
+		1961: `1961-04-02`,
+		2000: `2000-04-23`,
+		2019: `2019-04-21`,
+		2020: `2020-04-12`,
+		2024: `2024-03-31`,
+		2038: `2038-04-25`,
+	}
+
+	for year, date := range cases {
+		assert.Equal(t, date, easter(year, time.UTC).Format("2006-01-02"))
+	}
+}
+
+// Test holidays.
+func TestHolidays(t *testing.T) {
+	cases := map[string]string{
+		"new year's day":   `2019-01-01`,
+		"mlk day":          `2019-01-21`,
+		"presidents day":   `2019-02-18`,
+		"good friday":      `2019-04-19`,
+		"easter monday":    `2019-04-22`,
+		"mother's day":     `2019-05-12`,
+		"memorial day":     `2019-05-27`,
+		"father's day":     `2019-06-16`,
+		"labor day":        `2019-09-02`,
+		"columbus day":     `2019-10-14`,
+		"thanksgiving":     `2019-11-28`,
+		"christmas":        `2019-12-25`,
+		"new year's eve":   `2019-12-31`,
+		"independence day": `2019-07-04`,
+	}
+
+	for name, date := range cases {
+		assert.Equal(t, date, holidays[name](2019, time.UTC).Format("2006-01-02"), name)
+	}
+}
+
+// Test holidays observed on weekdays.
+func TestHolidaySet_observed(t *testing.T) {
+	cases := []struct {
+		Calendar HolidayCalendar
+		Year     int
+		Observed []string
+	}{
+		{USHolidays, 2019, nil},
+		{USHolidays, 2021, []string{
+			`2021-07-05 independence day (observed)`,
+			`2021-12-24 christmas (observed)`,
+			`2021-12-31 new year's day (observed)`,
+		}},
+		{UKHolidays, 2021, []string{
+			`2021-12-27 christmas (substitute day)`,
+			`2021-12-28 boxing day (substitute day)`,
+		}},
+		{UKHolidays, 2022, []string{
+			`2022-01-03 new year's day (substitute day)`,
+			`2022-12-27 christmas (substitute day)`,
+		}},
+		{DEHolidays, 2021, nil},
+	}
+
+	for _, c := range cases {
+		var observed []string
+		for _, h := range c.Calendar.Holidays(c.Year, time.UTC) {
+			if holidays[h.Name] == nil {
+				observed = append(observed, h.Date.Format("2006-01-02")+" "+h.Name)
+			}
+		}
+		assert.Equal(t, c.Observed, observed)
+	}
+}
+
+// Test parsing with holiday calendars.
+func TestParse_calendars(t *testing.T) {
+	for _, c := range calendarCases {
+		t.Run(c.Input, func(t *testing.T) {
+			v, err := Parse(c.Input, base, WithHolidays(c.Calendar))
+			assert.NoError(t, err, "parsing")
+			assert.Equal(t, c.Output, v.UTC().String())
+		})
+	}
+}
+
+// Test holiday names.
+func TestHolidayName(t *testing.T) {
+	assert.Equal(t, "christmas", holidayName("Christmas Day"))
+	assert.Equal(t, "new year's day", holidayName(" New Year's Day "))
+	assert.Equal(t, "whit monday", holidayName("Pentecost Monday"))
+	assert.Equal(t, "company offsite", holidayName("Company Offsite"))
+	assert.Equal(t, "christmas day (observed)", holidayName("Christmas Day (observed)"))
+}
@@ -0,0 +1,115 @@
+package naturaldate
+
+import "time"
+
+// OpeningHours is the time of day a business opens and closes, as durations
+// since midnight.
+type OpeningHours struct {
+	Open  time.Duration
+	Close time.Duration
+}
+
+// BusinessHours is the weekly opening hours used by business hour expressions
+// such as "in 4 business hours".
+type BusinessHours struct {
+	// Days is the opening hours of each weekday, indexed by time.Weekday. Days
+	// without opening hours are closed, as are weekends and observed holidays.
+	Days [7]OpeningHours
+
+	// Location is the time zone of the opening hours, defaulting to that of
+	// the reference time.
+	Location *time.Location
+}
+
+// DefaultBusinessHours is 9am to 5pm, Monday to Friday.
+var DefaultBusinessHours = BusinessHours{
+	Days: [7]OpeningHours{
+		time.Monday:    {9 * time.Hour, 17 * time.Hour},
+		time.Tuesday:   {9 * time.Hour, 17 * time.Hour},
+		time.Wednesday: {9 * time.Hour, 17 * time.Hour},
+		time.Thursday:  {9 * time.Hour, 17 * time.Hour},
+		time.Friday:    {9 * time.Hour, 17 * time.Hour},
+	},
+}
+
+// WithBusinessHours sets the opening hours used by business hour expressions.
+// By default DefaultBusinessHours is used.
+func WithBusinessHours(h BusinessHours) Option {
+	return func(p *parser) {
+		for _, d := range h.Days {
+			if d.Open < 0 || d.Close > 24*time.Hour {
+				panic("opening hours out of range")
+			}
+		}
+		p.hours = h
+	}
+}
+
+// openingHours returns the opening and closing time of the given day, or
+// false when closed.
+func (p *parser) openingHours(day time.Time) (open, close time.Time, ok bool) {
+	h := p.hours.Days[day.Weekday()]
+	if h.Close <= h.Open || !p.isBusinessDay(day) {
+		return
+	}
+
+	y, m, d := day.Date()
+	open = time.Date(y, m, d, 0, 0, int(h.Open/time.Second), 0, day.Location())
+	close = time.Date(y, m, d, 0, 0, int(h.Close/time.Second), 0, day.Location())
+	return open, close, true
+}
+
+// addBusinessTime returns time t with duration d of opening hours added,
+// counting backwards when d is negative.
+func (p *parser) addBusinessTime(t time.Time, d time.Duration) time.Time {
+	loc := p.hours.Location
+	if loc == nil {
+		loc = t.Location()
+	}
+
+	v := t.In(loc)
+	day := truncateDay(v)
+
+	for closed := 0; closed <= 366; {
+		open, close, ok := p.openingHours(day)
+		if !ok {
+			closed++
+		} else {
+			closed = 0
+		}
+
+		switch {
+		case ok && d >= 0:
+			if v.Before(open) {
+				v = open
+			}
+			if v.Before(close) {
+				if left := close.Sub(v); d <= left {
+					return v.Add(d).In(t.Location())
+				}
+				d -= close.Sub(v)
+			}
+		case ok:
+			if v.After(close) {
+				v = close
+			}
+			if v.After(open) {
+				if left := v.Sub(open); -d <= left {
+					return v.Add(d).In(t.Location())
+				}
+				d += v.Sub(open)
+			}
+		}
+
+		if d >= 0 {
+			day = day.AddDate(0, 0, 1)
+			v = day
+		} else {
+			day = day.AddDate(0, 0, -1)
+			v = day.AddDate(0, 0, 1)
+		}
+	}
+
+	p.errorf("no business hours")
+	return t
+}
@@ -0,0 +1,53 @@
+package naturaldate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tj/assert"
+)
+
+// Test parsing business hours.
+func TestParse_businessHours(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+
+	cases := []struct {
+		Options []Option
+		Input   string
+		Output  string
+	}{
+		{nil, `within 4 business hours`, `2019-11-26 09:07:18 +0000 UTC`},
+		{nil, `8 working hours from now`, `2019-11-26 13:07:18 +0000 UTC`},
+		{nil, `in 30 business minutes`, `2019-11-25 13:37:18 +0000 UTC`},
+		{nil, `in 3 business hours`, `2019-11-25 16:07:18 +0000 UTC`},
+		{nil, `2 business hours ago`, `2019-11-25 11:07:18 +0000 UTC`},
+		{nil, `6 business hours ago`, `2019-11-22 15:07:18 +0000 UTC`},
+		{nil, `5 business hours`, `2019-11-22 16:07:18 +0000 UTC`},
+		{nil, `in 24 business hours`, `2019-11-28 13:07:18 +0000 UTC`},
+		{[]Option{WithHolidays(USHolidays)}, `in 24 business hours`, `2019-11-29 13:07:18 +0000 UTC`},
+		{[]Option{WithWeekend(time.Friday, time.Saturday)}, `6 business hours ago`, `2019-11-21 15:07:18 +0000 UTC`},
+		{[]Option{WithBusinessHours(BusinessHours{
+			Days:     DefaultBusinessHours.Days,
+			Location: est,
+		})}, `in 1 business hour`, `2019-11-25 15:00:00 +0000 UTC`},
+		{[]Option{WithBusinessHours(BusinessHours{
+			Days: [7]OpeningHours{
+				time.Saturday: {10 * time.Hour, 14 * time.Hour},
+			},
+		}), WithWeekend()}, `in 6 business hours`, `2019-12-07 12:00:00 +0000 UTC`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Input, func(t *testing.T) {
+			v, err := Parse(c.Input, base, c.Options...)
+			assert.NoError(t, err, "parsing")
+			assert.Equal(t, c.Output, v.UTC().String())
+		})
+	}
+}
+
+// Test parsing business hours when always closed.
+func TestParse_businessHoursClosed(t *testing.T) {
+	_, err := Parse(`in 2 business hours`, base, WithBusinessHours(BusinessHours{}))
+	assert.EqualError(t, err, `no business hours`)
+}
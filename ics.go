@@ -0,0 +1,287 @@
+package naturaldate
+
+import (
+	"bufio"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// now returns the current time, used to stamp iCalendar components.
+var now = time.Now
+
+// LoadICS loads a holiday calendar from an iCalendar file, see ParseICS.
+func LoadICS(path string) (HolidayCalendar, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseICS(f)
+}
+
+// ParseICS parses a holiday calendar from iCalendar events. Each day of an
+// event is a holiday, and events recurring yearly are observed on each
+// occurrence of their rule. Event summaries such as "Christmas Day" are named as
+// they are in expressions, in this case "christmas".
+func ParseICS(r io.Reader) (HolidayCalendar, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var c icsCalendar
+	var event map[string]string
+
+	for _, line := range lines {
+		name, value := splitProperty(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			event = make(map[string]string)
+		case name == "END" && value == "VEVENT":
+			if err := c.add(event); err != nil {
+				return nil, err
+			}
+			event = nil
+		case event != nil:
+			event[name] = value
+		}
+	}
+
+	return &c, nil
+}
+
+// icsCalendar is a holiday calendar of iCalendar events.
+type icsCalendar struct {
+	once   []Holiday
+	yearly []icsRule
+}
+
+// icsRule is a holiday recurring yearly.
+type icsRule struct {
+	name       string
+	days       int
+	recurrence Recurrence
+}
+
+// Holidays implementation.
+func (c *icsCalendar) Holidays(year int, loc *time.Location) (v []Holiday) {
+	for _, h := range c.once {
+		if h.Date.Year() == year {
+			v = append(v, Holiday{Name: h.Name, Date: inLocation(h.Date, year, loc)})
+		}
+	}
+
+	for _, h := range c.yearly {
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(1, 0, 0)
+		for _, t := range h.recurrence.Between(start.AddDate(0, 0, 1-h.days), end) {
+			for i := 0; i < h.days; i++ {
+				if d := t.AddDate(0, 0, i); d.Year() == year {
+					v = append(v, Holiday{Name: h.name, Date: inLocation(d, year, loc)})
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// add an event.
+func (c *icsCalendar) add(event map[string]string) error {
+	start, err := parseICSDate(event["DTSTART"])
+	if err != nil {
+		return fmt.Errorf("parsing DTSTART: %w", err)
+	}
+
+	end := start.AddDate(0, 0, 1)
+	if v, ok := event["DTEND"]; ok {
+		end, err = parseICSDate(v)
+		if err != nil {
+			return fmt.Errorf("parsing DTEND: %w", err)
+		}
+	}
+
+	days := int(end.Sub(start).Hours() / 24)
+	if days < 1 {
+		days = 1
+	}
+
+	name := holidayName(unescapeText(event["SUMMARY"]))
+
+	if v, ok := event["RRULE"]; ok {
+		r, err := ParseRRule(v, start)
+		if err != nil {
+			return fmt.Errorf("parsing RRULE: %w", err)
+		}
+		if r.Frequency != Yearly {
+			return fmt.Errorf("unsupported recurrence rule %q", v)
+		}
+		c.yearly = append(c.yearly, icsRule{name: name, days: days, recurrence: r})
+		return nil
+	}
+
+	for i := 0; i < days; i++ {
+		c.once = append(c.once, Holiday{Name: name, Date: start.AddDate(0, 0, i)})
+	}
+
+	return nil
+}
+
+// holidayName returns the name used in expressions for the given holiday
+// summary, such as "christmas" for "Christmas Day". Unknown summaries are
+// returned in lowercase.
+func holidayName(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	p := &parser{Buffer: s}
+	p.Init()
+
+	if err := p.Parse(int(ruleHolidayName)); err != nil {
+		return s
+	}
+
+	if tokens := p.Tokens(); int(tokens[0].end) != len(p.buffer)-1 {
+		return s
+	}
+
+	p.Execute()
+	return p.holiday
+}
+
+// inLocation returns the date of time t in the given year and location.
+func inLocation(t time.Time, year int, loc *time.Location) time.Time {
+	return time.Date(year, t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// parseICSDate parses the date of an iCalendar DATE or DATE-TIME value.
+func parseICSDate(s string) (time.Time, error) {
+	if len(s) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", s)
+	}
+	return time.Parse("20060102", s[:8])
+}
+
+// splitProperty returns the name and value of a content line, discarding
+// any parameters.
+func splitProperty(line string) (name, value string) {
+	i := strings.Index(line, ":")
+	if i == -1 {
+		return line, ""
+	}
+	name, value = line[:i], line[i+1:]
+	if j := strings.Index(name, ";"); j != -1 {
+		name = name[:j]
+	}
+	return strings.ToUpper(name), value
+}
+
+// unfold returns the content lines of r, joining folded lines.
+func unfold(r io.Reader) (lines []string, err error) {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimRight(s.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, s.Err()
+}
+
+// unescapeText unescapes an iCalendar TEXT value.
+func unescapeText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\,`, `,`, `\;`, `;`, `\n`, " ", `\N`, " ").Replace(s)
+}
+
+// VEvent returns the recurrence as an iCalendar VEVENT component with the
+// given summary, starting in the time zone of the recurrence.
+func (r Recurrence) VEvent(summary string) string {
+	return component("VEVENT", summary, r.Start, "RRULE:"+r.RRule())
+}
+
+// VTodo returns the recurrence as an iCalendar VTODO component with the
+// given summary, starting in the time zone of the recurrence.
+func (r Recurrence) VTodo(summary string) string {
+	return component("VTODO", summary, r.Start, "RRULE:"+r.RRule())
+}
+
+// component returns an iCalendar component of the given kind, with extra
+// properties following the start. The UID is derived from its properties, so
+// the same schedule is always the same component.
+func component(kind, summary string, start time.Time, extra ...string) string {
+	props := []string{"DTSTART" + dateTimeValue(start)}
+	props = append(props, extra...)
+	props = append(props, "SUMMARY:"+escapeText(summary))
+
+	h := fnv.New64a()
+	io.WriteString(h, kind+strings.Join(props, "\n"))
+
+	lines := []string{
+		"BEGIN:" + kind,
+		fmt.Sprintf("UID:%x@naturaldate", h.Sum64()),
+		"DTSTAMP:" + now().UTC().Format("20060102T150405Z"),
+	}
+	lines = append(lines, props...)
+	lines = append(lines, "END:"+kind)
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(fold(line))
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+// dateTimeValue returns the parameters and value of an iCalendar DATE-TIME
+// property, with the TZID of its location, or in UTC when the location is not
+// a named time zone such as time.Local.
+func dateTimeValue(t time.Time) string {
+	if !isZoneName(t) {
+		return ":" + t.UTC().Format("20060102T150405Z")
+	}
+	return ";TZID=" + t.Location().String() + ":" + t.Format("20060102T150405")
+}
+
+// isZoneName reports whether the location of time t is a time zone database
+// name with the same offset at t, and so may be used as a TZID.
+func isZoneName(t time.Time) bool {
+	name := t.Location().String()
+	if name == "UTC" || name == "Local" || name == "" {
+		return false
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return false
+	}
+
+	_, a := t.Zone()
+	_, b := t.In(loc).Zone()
+	return a == b
+}
+
+// fold splits a content line into lines of at most 75 octets, continued
+// with a leading space.
+func fold(line string) string {
+	var b strings.Builder
+	n := 0
+	for _, r := range line {
+		size := len(string(r))
+		if n+size > 75 {
+			b.WriteString("\r\n ")
+			n = 1
+		}
+		b.WriteRune(r)
+		n += size
+	}
+	return b.String()
+}
+
+// escapeText escapes an iCalendar TEXT value.
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `,`, `\,`, `;`, `\;`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
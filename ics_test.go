@@ -0,0 +1,110 @@
+package naturaldate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tj/assert"
+)
+
+// ics calendar.
+var ics = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20191225\r\n" +
+	"DTEND;VALUE=DATE:20191226\r\n" +
+	"SUMMARY:Christmas Day\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20191127\r\n" +
+	"DTEND;VALUE=DATE:20191130\r\n" +
+	"SUMMARY:Company\r\n" +
+	"  Offsite\\, Lisbon\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART:20180704T000000Z\r\n" +
+	"RRULE:FREQ=YEARLY\r\n" +
+	"SUMMARY:Independence Day\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+// Test parsing iCalendar holidays.
+func TestParseICS(t *testing.T) {
+	c, err := ParseICS(strings.NewReader(ics))
+	assert.NoError(t, err, "parsing")
+
+	var days []string
+	for _, h := range c.Holidays(2019, time.UTC) {
+		days = append(days, h.Date.Format("2006-01-02")+" "+h.Name)
+	}
+
+	assert.Equal(t, []string{
+		"2019-12-25 christmas",
+		"2019-11-27 company offsite, lisbon",
+		"2019-11-28 company offsite, lisbon",
+		"2019-11-29 company offsite, lisbon",
+		"2019-07-04 independence day",
+	}, days)
+
+	assert.Len(t, c.Holidays(2017, time.UTC), 0)
+}
+
+// Test parsing iCalendar holidays with recurrence rules.
+func TestParseICS_rules(t *testing.T) {
+	c, err := ParseICS(strings.NewReader("BEGIN:VEVENT\r\n" +
+		"DTSTART;VALUE=DATE:20180101\r\n" +
+		"RRULE:FREQ=YEARLY;BYMONTH=7;BYMONTHDAY=4;UNTIL=20201231\r\n" +
+		"SUMMARY:Independence Day\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;VALUE=DATE:20181231\r\n" +
+		"DTEND;VALUE=DATE:20190102\r\n" +
+		"RRULE:FREQ=YEARLY\r\n" +
+		"SUMMARY:Winter Break\r\n" +
+		"END:VEVENT\r\n"))
+	assert.NoError(t, err, "parsing")
+
+	var days []string
+	for _, h := range c.Holidays(2020, time.UTC) {
+		days = append(days, h.Date.Format("2006-01-02")+" "+h.Name)
+	}
+
+	assert.Equal(t, []string{
+		"2020-07-04 independence day",
+		"2020-01-01 winter break",
+		"2020-12-31 winter break",
+	}, days)
+
+	assert.Len(t, c.Holidays(2021, time.UTC), 2)
+	assert.Len(t, c.Holidays(2017, time.UTC), 0)
+
+	c, err = ParseICS(strings.NewReader("BEGIN:VEVENT\nDTSTART:20181122\nRRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH\nSUMMARY:Thanksgiving\nEND:VEVENT\n"))
+	assert.NoError(t, err, "parsing")
+
+	days = nil
+	for _, h := range c.Holidays(2019, time.UTC) {
+		days = append(days, h.Date.Format("2006-01-02")+" "+h.Name)
+	}
+
+	assert.Equal(t, []string{"2019-11-28 thanksgiving"}, days)
+}
+
+// Test parsing invalid iCalendar holidays.
+func TestParseICS_invalid(t *testing.T) {
+	_, err := ParseICS(strings.NewReader("BEGIN:VEVENT\nDTSTART:2019\nEND:VEVENT\n"))
+	assert.EqualError(t, err, `parsing DTSTART: invalid date "2019"`)
+
+	_, err = ParseICS(strings.NewReader("BEGIN:VEVENT\nDTSTART:20191225\nRRULE:FREQ=WEEKLY\nEND:VEVENT\n"))
+	assert.EqualError(t, err, `unsupported recurrence rule "FREQ=WEEKLY"`)
+}
+
+// Test parsing with iCalendar holidays.
+func TestParse_ics(t *testing.T) {
+	c, err := ParseICS(strings.NewReader(ics))
+	assert.NoError(t, err, "parsing")
+
+	v, err := Parse(`the last business day of this month`, base, WithHolidays(c))
+	assert.NoError(t, err, "parsing")
+	assert.Equal(t, `2019-11-26 00:00:00 +0000 UTC`, v.String())
+}
@@ -4,6 +4,7 @@
 package naturaldate
 
 import (
+	"fmt"
 	"strings"
 	"time"
 )
@@ -23,6 +24,16 @@ const (
 	Future
 )
 
+// MonthOverflow is the behavior used when month or year arithmetic lands on
+// a day which does not exist, such as one month after January 31st.
+type MonthOverflow int
+
+// Month overflow behaviors available.
+const (
+	Clamp MonthOverflow = iota
+	Normalize
+)
+
 // Option function.
 type Option func(*parser)
 
@@ -42,11 +53,118 @@ func WithDirection(d Direction) Option {
 	}
 }
 
+// WithLenientDates disables validation of the day of the month, so days
+// which do not exist overflow into the following month, for example
+// "february 31st" becomes March 3rd.
+func WithLenientDates() Option {
+	return func(p *parser) {
+		p.lenient = true
+	}
+}
+
+// WithMonthOverflow sets the behavior of month and year arithmetic. By default
+// Clamp is used, so "1 month from now" on January 31st will be the last day
+// of February, whereas Normalize overflows into March as time.AddDate does.
+func WithMonthOverflow(o MonthOverflow) Option {
+	return func(p *parser) {
+		switch o {
+		case Clamp, Normalize:
+			p.overflow = o
+		default:
+			panic("unhandled month overflow")
+		}
+	}
+}
+
+// Range is a time range, where End is exclusive.
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+// WithWeekStart sets the first day of the week used for calendar weeks such
+// as "this week" or "end of last week". By default weeks start on Monday.
+func WithWeekStart(d time.Weekday) Option {
+	return func(p *parser) {
+		if d < time.Sunday || d > time.Saturday {
+			panic("unhandled weekday")
+		}
+		p.weekStart = d
+	}
+}
+
+// WithWeekend sets the days of the weekend, which are skipped by business day
+// expressions. By default weekends are Saturday and Sunday.
+func WithWeekend(days ...time.Weekday) Option {
+	return func(p *parser) {
+		seen := make(map[time.Weekday]bool)
+		for _, d := range days {
+			if d < time.Sunday || d > time.Saturday {
+				panic("unhandled weekday")
+			}
+			seen[d] = true
+		}
+		if len(seen) == 7 {
+			panic("weekend must exclude at least one day")
+		}
+		p.weekend = days
+	}
+}
+
 // Parse query string.
 func Parse(s string, ref time.Time, options ...Option) (time.Time, error) {
+	p, err := parse(s, ref, options...)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return p.t, nil
+}
+
+// ParseRange parses query string, returning the whole period referred to,
+// such as the month for "start of next month". Expressions which do not
+// refer to a period return an empty range at the time parsed.
+func ParseRange(s string, ref time.Time, options ...Option) (Range, error) {
+	p, err := parse(s, ref, options...)
+	if err != nil {
+		return Range{}, err
+	}
+
+	if p.period == periodNone {
+		return Range{Start: p.t, End: p.t}, nil
+	}
+
+	start := startOf(p.t, p.period, p.weekStart)
+	return Range{
+		Start: start,
+		End:   addPeriod(start, p.period, 1),
+	}, nil
+}
+
+// parse query string.
+func parse(s string, ref time.Time, options ...Option) (*parser, error) {
+	p := newParser(s, ref, options)
+	p.Init()
+
+	if err := p.Parse(); err != nil {
+		return nil, err
+	}
+
+	if err := p.execute(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// newParser returns a parser of query string s with the options applied.
+func newParser(s string, ref time.Time, options []Option) *parser {
 	p := &parser{
 		Buffer:    strings.ToLower(s),
 		direction: -1,
+		weekStart: time.Monday,
+		weekend:   []time.Weekday{time.Saturday, time.Sunday},
+		hours:     DefaultBusinessHours,
 		t:         ref,
 	}
 
@@ -54,15 +172,174 @@ func Parse(s string, ref time.Time, options ...Option) (time.Time, error) {
 		o(p)
 	}
 
-	p.Init()
-
-	if err := p.Parse(); err != nil {
-		return time.Time{}, err
-	}
+	return p
+}
 
+// execute runs the actions of the parsed query, resolving the time.
+func (p *parser) execute() error {
 	p.Execute()
 	// p.PrintSyntaxTree()
-	return p.t, nil
+
+	if p.day != 0 {
+		p.resolveDay()
+	}
+
+	for i := len(p.later) - 1; i >= 0; i-- {
+		p.later[i]()
+	}
+
+	return p.err
+}
+
+// errorf records an error, only the first error is retained.
+func (p *parser) errorf(format string, args ...interface{}) {
+	if p.err == nil {
+		p.err = fmt.Errorf(format, args...)
+	}
+}
+
+// setDay sets the day of the month. Validation is deferred until the month
+// is resolved, as in "the 31st of next month", so until then the day is
+// clamped to the end of the current month.
+func (p *parser) setDay(day int) {
+	if p.lenient {
+		y, m, _ := p.t.Date()
+		hour, min, sec := p.t.Clock()
+		p.t = time.Date(y, m, day, hour, min, sec, 0, p.t.Location())
+		return
+	}
+	if day < 1 {
+		y, m, _ := p.t.Date()
+		p.errorf("invalid day %d for %s %d", day, m, y)
+		return
+	}
+	p.day = day
+	p.t = withDay(p.t, day)
+}
+
+// resolveDay validates the day of the month against the resolved month.
+func (p *parser) resolveDay() {
+	y, m, _ := p.t.Date()
+	if p.day > daysIn(y, m) {
+		p.errorf("invalid day %d for %s %d", p.day, m, y)
+		return
+	}
+	p.t = withDay(p.t, p.day)
+}
+
+// setISOWeek sets the time to the start of the ISO 8601 week of the year.
+func (p *parser) setISOWeek(year, week int) {
+	t := isoWeekStart(year, week, p.t.Location())
+	if y, w := t.ISOWeek(); y != year || w != week {
+		p.errorf("invalid week %d for %d", week, year)
+		return
+	}
+	p.period = periodISOWeek
+	p.t = t
+}
+
+// setNthWeekday sets the day to the nth week day of the month, counting from
+// the end of the month when n is negative.
+func (p *parser) setNthWeekday(n int, day time.Weekday) {
+	t, ok := nthWeekday(p.t, n, day)
+	if !ok {
+		y, m, _ := p.t.Date()
+		p.errorf("invalid %s %s for %s %d", ordinals[n], day, m, y)
+		return
+	}
+	p.period = periodDay
+	p.t = t
+}
+
+// addMonths adds n months, retaining the day of the month set by setDay,
+// otherwise applying the month overflow behavior.
+func (p *parser) addMonths(n int) {
+	if p.day == 0 && p.overflow == Normalize {
+		p.t = p.t.AddDate(0, n, 0)
+		return
+	}
+	p.t = addMonths(p.t, n)
+	if p.day != 0 {
+		p.t = withDay(p.t, p.day)
+	}
+}
+
+// addPeriod adds n periods, applying the month overflow behavior to months,
+// quarters and years.
+func (p *parser) addPeriod(unit period, n int) {
+	switch unit {
+	case periodBusinessDay:
+		p.t = p.addBusinessDays(p.t, n)
+	case periodMonth:
+		p.addMonths(n)
+	case periodQuarter:
+		p.addMonths(3 * n)
+	case periodYear:
+		p.addMonths(12 * n)
+	default:
+		p.t = addPeriod(p.t, unit, n)
+	}
+}
+
+// setHour sets the hour, truncating minutes and seconds.
+func (p *parser) setHour(hour int) {
+	if hour < 0 || hour > 23 {
+		p.errorf("invalid hour %d", hour)
+		return
+	}
+	y, m, d := p.t.Date()
+	p.t = time.Date(y, m, d, hour, 0, 0, 0, p.t.Location())
+	p.clock = true
+}
+
+// setHour12 sets the hour using the 12-hour clock, where 12am is midnight
+// and 12pm is noon.
+func (p *parser) setHour12(hour int, pm bool) {
+	if hour < 1 || hour > 12 {
+		p.errorf("invalid hour %d", hour)
+		return
+	}
+	hour %= 12
+	if pm {
+		hour += 12
+	}
+	p.setHour(hour)
+}
+
+// afterDigit returns true if the rune before position i is a digit, so that a
+// meridiem of a single letter is attached to the time, as in "5p", rather than
+// being a word such as "a" in "at 5 a meeting".
+func (p *parser) afterDigit(i uint32) bool {
+	return i > 0 && p.buffer[i-1] >= '0' && p.buffer[i-1] <= '9'
+}
+
+// setMinute sets the minute, truncating seconds.
+func (p *parser) setMinute(min int) {
+	if min < 0 || min > 59 {
+		p.errorf("invalid minute %d", min)
+		return
+	}
+	y, m, d := p.t.Date()
+	p.t = time.Date(y, m, d, p.t.Hour(), min, 0, 0, p.t.Location())
+}
+
+// setSecond sets the second.
+func (p *parser) setSecond(sec int) {
+	if sec < 0 || sec > 59 {
+		p.errorf("invalid second %d", sec)
+		return
+	}
+	y, m, d := p.t.Date()
+	p.t = time.Date(y, m, d, p.t.Hour(), p.t.Minute(), sec, 0, p.t.Location())
+}
+
+// truncateDay returns a date truncated to the day, unless a time of day has
+// already been specified, as in "9am tomorrow".
+func (p *parser) truncateDay(t time.Time) time.Time {
+	if p.clock {
+		return t
+	}
+	return truncateDay(t)
 }
 
 // withDirection returns duration with direction.
@@ -72,20 +349,129 @@ func (p *parser) withDirection(d time.Duration) time.Duration {
 
 // prevWeekday returns the previous week day relative to time t.
 func prevWeekday(t time.Time, day time.Weekday) time.Time {
-	d := t.Weekday() - day
-	if d <= 0 {
-		d += 7
-	}
-	return t.Add(-time.Hour * 24 * time.Duration(d))
+	return addWeekday(t, day, -1)
 }
 
 // nextWeekday returns the next week day relative to time t.
 func nextWeekday(t time.Time, day time.Weekday) time.Time {
+	return addWeekday(t, day, 1)
+}
+
+// addWeekday returns the nth week day relative to time t, counting backwards
+// when n is negative.
+func addWeekday(t time.Time, day time.Weekday, n int) time.Time {
+	if n < 0 {
+		d := t.Weekday() - day
+		if d <= 0 {
+			d += 7
+		}
+		return t.AddDate(0, 0, -int(d)+(n+1)*7)
+	}
+
 	d := day - t.Weekday()
 	if d <= 0 {
 		d += 7
 	}
-	return t.Add(time.Hour * 24 * time.Duration(d))
+	return t.AddDate(0, 0, int(d)+(n-1)*7)
+}
+
+// thisWeekday returns the week day within the week of time t, where weeks
+// start on weekStart. The day may be before t, such as the Friday before a
+// Saturday when weeks start on Monday.
+func thisWeekday(t time.Time, day, weekStart time.Weekday) time.Time {
+	d := int(day-weekStart+7)%7 - int(t.Weekday()-weekStart+7)%7
+	return t.AddDate(0, 0, d)
+}
+
+// ordinals used in error messages.
+var ordinals = map[int]string{
+	-1: "last",
+	1:  "first",
+	2:  "second",
+	3:  "third",
+	4:  "fourth",
+	5:  "fifth",
+}
+
+// nthWeekday returns the nth week day within the month of time t, counting
+// from the end of the month when n is negative. False is returned when the
+// month has no such day.
+func nthWeekday(t time.Time, n int, day time.Weekday) (time.Time, bool) {
+	y, m, _ := t.Date()
+	if n < 0 {
+		last := withDay(t, daysIn(y, m))
+		d := last.Day() - int(last.Weekday()-day+7)%7 + (n+1)*7
+		return withDay(t, d), d >= 1
+	}
+	first := withDay(t, 1)
+	d := 1 + int(day-first.Weekday()+7)%7 + (n-1)*7
+	return withDay(t, d), d <= daysIn(y, m)
+}
+
+// nthDay returns the nth day within the month of time t, counting from the
+// end of the month when n is negative.
+func nthDay(t time.Time, n int) time.Time {
+	if n < 0 {
+		y, m, _ := t.Date()
+		return withDay(t, daysIn(y, m)+n+1)
+	}
+	return withDay(t, n)
+}
+
+// nthBusinessDay returns the nth business day within the month of time t,
+// counting from the end of the month when n is negative.
+func (p *parser) nthBusinessDay(t time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step = -1
+		t = nthDay(t, -1)
+	} else {
+		t = nthDay(t, 1)
+	}
+
+	for {
+		if p.isBusinessDay(t) {
+			n -= step
+			if n == 0 {
+				return t
+			}
+		}
+		t = t.AddDate(0, 0, step)
+	}
+}
+
+// addBusinessDays returns time t with n business days added, counting
+// backwards when n is negative.
+func (p *parser) addBusinessDays(t time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step = -1
+	}
+
+	for n != 0 {
+		t = t.AddDate(0, 0, step)
+		if p.isBusinessDay(t) {
+			n -= step
+		}
+	}
+
+	return t
+}
+
+// isBusinessDay returns true if time t falls on neither a weekend nor a
+// holiday.
+func (p *parser) isBusinessDay(t time.Time) bool {
+	return !p.isWeekend(t) && !p.isHoliday(t)
+}
+
+// isWeekend returns true if time t falls on the weekend.
+func (p *parser) isWeekend(t time.Time) bool {
+	for _, d := range p.weekend {
+		if t.Weekday() == d {
+			return true
+		}
+	}
+	return false
 }
 
 // nextMonth returns the next month relative to time t.
@@ -94,20 +480,129 @@ func nextMonth(t time.Time, month time.Month) time.Time {
 	if month-t.Month() <= 0 {
 		y++
 	}
-	_, _, day := t.Date()
 	hour, min, sec := t.Clock()
-	return time.Date(y, month, day, hour, min, sec, 0, t.Location())
+	return withDay(time.Date(y, month, 1, hour, min, sec, 0, t.Location()), t.Day())
 }
 
-// prevMonth returns the next month relative to time t.
+// prevMonth returns the previous month relative to time t.
 func prevMonth(t time.Time, month time.Month) time.Time {
 	y := t.Year()
 	if t.Month()-month <= 0 {
 		y--
 	}
-	_, _, day := t.Date()
 	hour, min, sec := t.Clock()
-	return time.Date(y, month, day, hour, min, sec, 0, t.Location())
+	return withDay(time.Date(y, month, 1, hour, min, sec, 0, t.Location()), t.Day())
+}
+
+// isoWeekStart returns the Monday starting the ISO 8601 week of the year.
+func isoWeekStart(year, week int, loc *time.Location) time.Time {
+	t := time.Date(year, 1, 4, 0, 0, 0, 0, loc)
+	return t.AddDate(0, 0, (week-1)*7-int(t.Weekday()+6)%7)
+}
+
+// thisMonth returns the month within the year of time t.
+func thisMonth(t time.Time, month time.Month) time.Time {
+	hour, min, sec := t.Clock()
+	return withDay(time.Date(t.Year(), month, 1, hour, min, sec, 0, t.Location()), t.Day())
+}
+
+// withDay returns time t with the given day of the month, clamped to the
+// last day of the month.
+func withDay(t time.Time, day int) time.Time {
+	y, m, _ := t.Date()
+	if n := daysIn(y, m); day > n {
+		day = n
+	}
+	hour, min, sec := t.Clock()
+	return time.Date(y, m, day, hour, min, sec, 0, t.Location())
+}
+
+// daysIn returns the number of days in the month of year y.
+func daysIn(y int, m time.Month) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// period is a unit of calendar time.
+type period int
+
+// Periods available.
+const (
+	periodNone period = iota
+	periodMinute
+	periodHour
+	periodDay
+	periodBusinessDay
+	periodWeek
+	periodISOWeek
+	periodMonth
+	periodQuarter
+	periodYear
+)
+
+// startOf returns the start of the period containing time t, where weeks
+// start on weekStart.
+func startOf(t time.Time, p period, weekStart time.Weekday) time.Time {
+	y, m, d := t.Date()
+	switch p {
+	case periodMinute:
+		return time.Date(y, m, d, t.Hour(), t.Minute(), 0, 0, t.Location())
+	case periodHour:
+		return time.Date(y, m, d, t.Hour(), 0, 0, 0, t.Location())
+	case periodDay, periodBusinessDay:
+		return truncateDay(t)
+	case periodWeek:
+		return truncateDay(t.AddDate(0, 0, -int(t.Weekday()-weekStart+7)%7))
+	case periodISOWeek:
+		return startOf(t, periodWeek, time.Monday)
+	case periodMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+	case periodQuarter:
+		return time.Date(y, m-(m-1)%3, 1, 0, 0, 0, 0, t.Location())
+	case periodYear:
+		return time.Date(y, 1, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return t
+	}
+}
+
+// endOf returns the last instant of the period containing time t, where weeks
+// start on weekStart.
+func endOf(t time.Time, p period, weekStart time.Weekday) time.Time {
+	if p == periodNone {
+		return t
+	}
+	return addPeriod(startOf(t, p, weekStart), p, 1).Add(-time.Nanosecond)
+}
+
+// addPeriod returns time t with n periods added, clamping to the end of the
+// month for months, quarters and years.
+func addPeriod(t time.Time, p period, n int) time.Time {
+	switch p {
+	case periodMinute:
+		return t.Add(time.Minute * time.Duration(n))
+	case periodHour:
+		return t.Add(time.Hour * time.Duration(n))
+	case periodDay, periodBusinessDay:
+		return t.AddDate(0, 0, n)
+	case periodWeek, periodISOWeek:
+		return t.AddDate(0, 0, 7*n)
+	case periodMonth:
+		return addMonths(t, n)
+	case periodQuarter:
+		return addMonths(t, 3*n)
+	case periodYear:
+		return addMonths(t, 12*n)
+	default:
+		return t
+	}
+}
+
+// addMonths returns time t with n months added, clamped to the end of the
+// month.
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	hour, min, sec := t.Clock()
+	return withDay(time.Date(y, m+time.Month(n), 1, hour, min, sec, 0, t.Location()), d)
 }
 
 // truncateDay returns a date truncated to the day.
@@ -60,6 +60,16 @@ var pastCases = []struct {
 	{`Remind me in one day`, `2019-11-26 13:07:18 +0000 UTC`},
 	{`Remind me in one day from now`, `2019-11-26 13:07:18 +0000 UTC`},
 
+	// business days
+	{`in 5 business days`, `2019-12-02 13:07:18 +0000 UTC`},
+	{`3 business days from now`, `2019-11-28 13:07:18 +0000 UTC`},
+	{`2 working days ago`, `2019-11-21 00:00:00 +0000 UTC`},
+	{`5 business days`, `2019-11-18 00:00:00 +0000 UTC`},
+	{`next business day at 9am`, `2019-11-26 09:00:00 +0000 UTC`},
+	{`last business day`, `2019-11-22 00:00:00 +0000 UTC`},
+	{`next weekday`, `2019-11-26 00:00:00 +0000 UTC`},
+	{`3 business days after christmas`, `2018-12-28 00:00:00 +0000 UTC`},
+
 	// weeks
 	{`1 week`, `2019-11-18 00:00:00 +0000 UTC`},
 	{`1 week ago`, `2019-11-18 00:00:00 +0000 UTC`},
@@ -73,6 +83,40 @@ var pastCases = []struct {
 	{`Message me two weeks from now`, `2019-12-09 13:07:18 +0000 UTC`},
 	{`Message me in two weeks`, `2019-12-09 13:07:18 +0000 UTC`},
 
+	// holidays
+	{`christmas`, `2018-12-25 00:00:00 +0000 UTC`},
+	{`Christmas Day`, `2018-12-25 00:00:00 +0000 UTC`},
+	{`next christmas`, `2019-12-25 00:00:00 +0000 UTC`},
+	{`this christmas`, `2019-12-25 00:00:00 +0000 UTC`},
+	{`last christmas`, `2018-12-25 00:00:00 +0000 UTC`},
+	{`christmas 2020`, `2020-12-25 00:00:00 +0000 UTC`},
+	{`christmas eve at 6pm`, `2018-12-24 18:00:00 +0000 UTC`},
+	{`new year's eve`, `2018-12-31 00:00:00 +0000 UTC`},
+	{`new years day`, `2019-01-01 00:00:00 +0000 UTC`},
+	{`thanksgiving`, `2018-11-22 00:00:00 +0000 UTC`},
+	{`easter`, `2019-04-21 00:00:00 +0000 UTC`},
+	{`good friday`, `2019-04-19 00:00:00 +0000 UTC`},
+	{`memorial day`, `2019-05-27 00:00:00 +0000 UTC`},
+	{`labour day`, `2019-09-02 00:00:00 +0000 UTC`},
+	{`the 4th of july`, `2019-07-04 00:00:00 +0000 UTC`},
+	{`halloween`, `2019-10-31 00:00:00 +0000 UTC`},
+	{`3 days after christmas`, `2018-12-28 00:00:00 +0000 UTC`},
+	{`freeze until new year's day`, `2019-01-01 00:00:00 +0000 UTC`},
+	{`tomorrow at 9am eastern`, `2019-11-26 09:00:00 +0000 UTC`},
+	{`christmastime`, `2019-11-25 13:07:18 +0000 UTC`},
+	{`halloweenie tomorrow`, `2019-11-26 00:00:00 +0000 UTC`},
+
+	// iso weeks
+	{`week 42`, `2019-10-14 00:00:00 +0000 UTC`},
+	{`week 42 at 5pm`, `2019-10-14 17:00:00 +0000 UTC`},
+	{`W42 2019`, `2019-10-14 00:00:00 +0000 UTC`},
+	{`week 1 of 2020`, `2019-12-30 00:00:00 +0000 UTC`},
+	{`2019-W42`, `2019-10-14 00:00:00 +0000 UTC`},
+	{`2019-W42-3`, `2019-10-16 00:00:00 +0000 UTC`},
+	{`2019W423`, `2019-10-16 00:00:00 +0000 UTC`},
+	{`2020-W53`, `2020-12-28 00:00:00 +0000 UTC`},
+	{`the week of december 23rd`, `2018-12-17 00:00:00 +0000 UTC`},
+
 	// months
 	{`1 month ago`, `2019-10-25 13:07:18 +0000 UTC`},
 	{`last month`, `2019-10-25 13:07:18 +0000 UTC`},
@@ -90,6 +134,14 @@ var pastCases = []struct {
 	{`Remind me in a month from now`, `2019-12-25 13:07:18 +0000 UTC`},
 	{`Remind me in 2 months from now`, `2020-01-25 13:07:18 +0000 UTC`},
 
+	// this
+	{`this minute`, `2019-11-25 13:07:18 +0000 UTC`},
+	{`this hour`, `2019-11-25 13:07:18 +0000 UTC`},
+	{`this week`, `2019-11-25 13:07:18 +0000 UTC`},
+	{`this month`, `2019-11-25 13:07:18 +0000 UTC`},
+	{`this month at 5pm`, `2019-11-25 17:00:00 +0000 UTC`},
+	{`this year`, `2019-11-25 13:07:18 +0000 UTC`},
+
 	// years
 	{`last year`, `2018-11-25 13:07:18 +0000 UTC`},
 	{`next year`, `2020-11-25 13:07:18 +0000 UTC`},
@@ -111,11 +163,30 @@ var pastCases = []struct {
 	{`yesterday at 10am`, `2019-11-24 10:00:00 +0000 UTC`},
 	{`yesterday at 10:15am`, `2019-11-24 10:15:00 +0000 UTC`},
 
+	// offsets
+	{`the day after tomorrow`, `2019-11-27 00:00:00 +0000 UTC`},
+	{`day after tomorrow at 5pm`, `2019-11-27 17:00:00 +0000 UTC`},
+	{`the day before yesterday`, `2019-11-23 00:00:00 +0000 UTC`},
+	{`the day after december 25th`, `2018-12-26 13:07:18 +0000 UTC`},
+	{`3 days after december 25th`, `2018-12-28 13:07:18 +0000 UTC`},
+	{`two days before next friday`, `2019-11-27 00:00:00 +0000 UTC`},
+	{`10 days before the last day of february`, `2019-02-18 00:00:00 +0000 UTC`},
+	{`2 weeks before december 25th`, `2018-12-11 13:07:18 +0000 UTC`},
+	{`3 hours after 9am tomorrow`, `2019-11-26 12:00:00 +0000 UTC`},
+	{`30 minutes before 5pm`, `2019-11-25 16:30:00 +0000 UTC`},
+	{`a day before next friday`, `2019-11-28 00:00:00 +0000 UTC`},
+	{`1 month after january 31st`, `2019-02-28 13:07:18 +0000 UTC`},
+	{`a year after next monday`, `2020-12-02 00:00:00 +0000 UTC`},
+	{`a week before the first monday of next month`, `2019-11-25 00:00:00 +0000 UTC`},
+
 	// tomorrow
 	{`tomorrow`, `2019-11-26 00:00:00 +0000 UTC`},
 	{`tomorrow 10am`, `2019-11-26 10:00:00 +0000 UTC`},
 	{`tomorrow at 10am`, `2019-11-26 10:00:00 +0000 UTC`},
 	{`tomorrow at 10:15am`, `2019-11-26 10:15:00 +0000 UTC`},
+	{`tomorrow at noon`, `2019-11-26 12:00:00 +0000 UTC`},
+	{`yesterday at midnight`, `2019-11-24 00:00:00 +0000 UTC`},
+	{`10am tomorrow`, `2019-11-26 10:00:00 +0000 UTC`},
 
 	// past weekdays
 	{`sunday`, `2019-11-24 00:00:00 +0000 UTC`},
@@ -135,6 +206,17 @@ var pastCases = []struct {
 	{`last friday`, `2019-11-22 00:00:00 +0000 UTC`},
 	{`last saturday`, `2019-11-23 00:00:00 +0000 UTC`},
 
+	// counted weekdays
+	{`2 tuesdays ago`, `2019-11-12 00:00:00 +0000 UTC`},
+	{`3 mondays ago`, `2019-11-04 00:00:00 +0000 UTC`},
+	{`1 sunday ago`, `2019-11-24 00:00:00 +0000 UTC`},
+	{`in 3 fridays`, `2019-12-13 00:00:00 +0000 UTC`},
+	{`two fridays from now`, `2019-12-06 00:00:00 +0000 UTC`},
+	{`in 1 monday`, `2019-12-02 00:00:00 +0000 UTC`},
+	{`2 wednesdays`, `2019-11-13 00:00:00 +0000 UTC`},
+	{`dinner at 7 friday`, `2019-11-22 07:00:00 +0000 UTC`},
+	{`at 9 monday`, `2019-11-18 09:00:00 +0000 UTC`},
+
 	// future weekdays
 	{`next tuesday`, `2019-11-26 00:00:00 +0000 UTC`},
 	{`next wednesday`, `2019-11-27 00:00:00 +0000 UTC`},
@@ -144,7 +226,48 @@ var pastCases = []struct {
 	{`next sunday`, `2019-12-01 00:00:00 +0000 UTC`},
 	{`next monday`, `2019-12-02 00:00:00 +0000 UTC`},
 
+	// this weekdays
+	{`this monday`, `2019-11-25 00:00:00 +0000 UTC`},
+	{`this friday`, `2019-11-29 00:00:00 +0000 UTC`},
+	{`this sunday`, `2019-12-01 00:00:00 +0000 UTC`},
+	{`this friday at 5pm`, `2019-11-29 17:00:00 +0000 UTC`},
+	{`at 5pm this friday`, `2019-11-29 17:00:00 +0000 UTC`},
+
+	// nth weekdays
+	{`the first monday of the month`, `2019-11-04 00:00:00 +0000 UTC`},
+	{`the 1st monday of this month`, `2019-11-04 00:00:00 +0000 UTC`},
+	{`the last friday of december`, `2018-12-28 00:00:00 +0000 UTC`},
+	{`the 3rd tuesday of next month`, `2019-12-17 00:00:00 +0000 UTC`},
+	{`first monday of next month at 9am`, `2019-12-02 09:00:00 +0000 UTC`},
+	{`the second wednesday in march`, `2019-03-13 00:00:00 +0000 UTC`},
+	{`the fourth thursday of november`, `2018-11-22 00:00:00 +0000 UTC`},
+	{`the last sunday of last month`, `2019-10-27 00:00:00 +0000 UTC`},
+
+	// nth days
+	{`on the last day of the month`, `2019-11-30 00:00:00 +0000 UTC`},
+	{`the last day of february`, `2019-02-28 00:00:00 +0000 UTC`},
+	{`the last day of february next year`, `2020-02-29 00:00:00 +0000 UTC`},
+	{`the first day of next month`, `2019-12-01 00:00:00 +0000 UTC`},
+	{`the last business day of next month`, `2019-12-31 00:00:00 +0000 UTC`},
+	{`the last working day of november`, `2018-11-30 00:00:00 +0000 UTC`},
+	{`the last weekday of this month`, `2019-11-29 00:00:00 +0000 UTC`},
+	{`the last business day of august at 5pm`, `2019-08-30 17:00:00 +0000 UTC`},
+	{`the first business day of next month`, `2019-12-02 00:00:00 +0000 UTC`},
+	{`the second business day of next month`, `2019-12-03 00:00:00 +0000 UTC`},
+
+	// weekdays in weeks
+	{`monday next week`, `2019-12-02 00:00:00 +0000 UTC`},
+	{`sunday next week`, `2019-12-08 00:00:00 +0000 UTC`},
+	{`friday last week`, `2019-11-22 00:00:00 +0000 UTC`},
+	{`thursday this week`, `2019-11-28 00:00:00 +0000 UTC`},
+	{`tuesday after next`, `2019-12-03 00:00:00 +0000 UTC`},
+	{`next week on thursday`, `2019-12-05 00:00:00 +0000 UTC`},
+	{`next week thursday at 10am`, `2019-12-05 10:00:00 +0000 UTC`},
+	{`last week on monday`, `2019-11-18 00:00:00 +0000 UTC`},
+
 	// months
+	{`this january`, `2019-01-25 13:07:18 +0000 UTC`},
+	{`this december`, `2019-12-25 13:07:18 +0000 UTC`},
 	{`last january`, `2019-01-25 13:07:18 +0000 UTC`},
 	{`next january`, `2020-01-25 13:07:18 +0000 UTC`},
 	{`january`, `2019-01-25 13:07:18 +0000 UTC`},
@@ -170,6 +293,32 @@ var pastCases = []struct {
 	{`december 23rd 5pm`, `2018-12-23 17:00:00 +0000 UTC`},
 	{`december 23rd at 5pm`, `2018-12-23 17:00:00 +0000 UTC`},
 	{`december 23rd at 5:25pm`, `2018-12-23 17:25:00 +0000 UTC`},
+	{`december 23rd at 5:25p`, `2018-12-23 17:25:00 +0000 UTC`},
+	{`december 23rd at 5p`, `2018-12-23 17:00:00 +0000 UTC`},
+	{`december 23rd at 5 p meeting`, `2018-12-23 05:00:00 +0000 UTC`},
+	{`february 28th`, `2019-02-28 13:07:18 +0000 UTC`},
+	{`february 29th next year`, `2020-02-29 13:07:18 +0000 UTC`},
+	{`the 31st of december`, `2018-12-31 13:07:18 +0000 UTC`},
+	{`the 31st of last month`, `2019-10-31 13:07:18 +0000 UTC`},
+	{`the 31st of next month`, `2019-12-31 13:07:18 +0000 UTC`},
+
+	// boundaries
+	{`start of this month`, `2019-11-01 00:00:00 +0000 UTC`},
+	{`beginning of the year`, `2019-01-01 00:00:00 +0000 UTC`},
+	{`start of next quarter`, `2020-01-01 00:00:00 +0000 UTC`},
+	{`start of the hour`, `2019-11-25 13:00:00 +0000 UTC`},
+	{`start of tomorrow`, `2019-11-26 00:00:00 +0000 UTC`},
+	{`end of last week`, `2019-11-24 23:59:59.999999999 +0000 UTC`},
+	{`end of this week`, `2019-12-01 23:59:59.999999999 +0000 UTC`},
+	{`end of the month`, `2019-11-30 23:59:59.999999999 +0000 UTC`},
+	{`end of the month at 5pm`, `2019-11-30 17:00:00 +0000 UTC`},
+	{`end of the quarter`, `2019-12-31 23:59:59.999999999 +0000 UTC`},
+	{`end of next year`, `2020-12-31 23:59:59.999999999 +0000 UTC`},
+	{`end of day`, `2019-11-25 23:59:59.999999999 +0000 UTC`},
+	{`end of yesterday`, `2019-11-24 23:59:59.999999999 +0000 UTC`},
+	{`Ship it by EOD`, `2019-11-25 23:59:59.999999999 +0000 UTC`},
+	{`Ship it by COB`, `2019-11-25 17:00:00 +0000 UTC`},
+	{`Ship it by close of business`, `2019-11-25 17:00:00 +0000 UTC`},
 
 	// 12-hour clock
 	{`10am`, `2019-11-25 10:00:00 +0000 UTC`},
@@ -179,6 +328,15 @@ var pastCases = []struct {
 	{`1:05pm`, `2019-11-25 13:05:00 +0000 UTC`},
 	{`10:25:10am`, `2019-11-25 10:25:10 +0000 UTC`},
 	{`1:05:10pm`, `2019-11-25 13:05:10 +0000 UTC`},
+	{`12am`, `2019-11-25 00:00:00 +0000 UTC`},
+	{`12:30am`, `2019-11-25 00:30:00 +0000 UTC`},
+	{`12pm`, `2019-11-25 12:00:00 +0000 UTC`},
+	{`12:30pm`, `2019-11-25 12:30:00 +0000 UTC`},
+	{`10a`, `2019-11-25 10:00:00 +0000 UTC`},
+	{`10p`, `2019-11-25 22:00:00 +0000 UTC`},
+	{`10 a.m.`, `2019-11-25 10:00:00 +0000 UTC`},
+	{`10:30 p.m.`, `2019-11-25 22:30:00 +0000 UTC`},
+	{`tomorrow at 9 a.m. please`, `2019-11-26 09:00:00 +0000 UTC`},
 
 	// 24-hour clock
 	{`10`, `2019-11-25 10:00:00 +0000 UTC`},
@@ -186,6 +344,8 @@ var pastCases = []struct {
 	{`10:25:30`, `2019-11-25 10:25:30 +0000 UTC`},
 	{`17`, `2019-11-25 17:00:00 +0000 UTC`},
 	{`17:25:30`, `2019-11-25 17:25:30 +0000 UTC`},
+	{`0:00`, `2019-11-25 00:00:00 +0000 UTC`},
+	{`23:59:59`, `2019-11-25 23:59:59 +0000 UTC`},
 
 	// case sensitivity
 	{`December 23rd AT 5:25 PM`, `2018-12-23 17:25:00 +0000 UTC`},
@@ -201,6 +361,21 @@ var pastCases = []struct {
 
 	// errors
 	{`10:am`, "\nparse error near PegText (line 1 symbol 1 - line 1 symbol 3):\n\"10\"\n"},
+	{`0am`, `invalid hour 0`},
+	{`13pm`, `invalid hour 13`},
+	{`24:00`, `invalid hour 24`},
+	{`25:99`, `invalid hour 25`},
+	{`10:60`, `invalid minute 60`},
+	{`10:30:60pm`, `invalid second 60`},
+	{`february 29th`, `invalid day 29 for February 2019`},
+	{`february 31st`, `invalid day 31 for February 2019`},
+	{`the 31st of february`, `invalid day 31 for February 2019`},
+	{`the 31st`, `invalid day 31 for November 2019`},
+	{`the 45th`, `invalid day 45 for November 2019`},
+	{`the 0th`, `invalid day 0 for November 2019`},
+	{`week 0`, `invalid week 0 for 2019`},
+	{`the fifth friday of february`, `invalid fifth Friday for February 2019`},
+	{`2019-W53`, `invalid week 53 for 2019`},
 }
 
 // futureCases are test cases for the future direction.
@@ -221,15 +396,127 @@ var futureCases = []struct {
 	{`saturday`, `2019-11-30 00:00:00 +0000 UTC`},
 	{`sunday`, `2019-12-01 00:00:00 +0000 UTC`},
 	{`monday`, `2019-12-02 00:00:00 +0000 UTC`},
+	{`2 sundays`, `2019-12-08 00:00:00 +0000 UTC`},
 	{`last january`, `2019-01-25 13:07:18 +0000 UTC`},
 	{`january`, `2020-01-25 13:07:18 +0000 UTC`},
 	{`next january`, `2020-01-25 13:07:18 +0000 UTC`},
 	{`Remind me on the December 25th at 7am`, `2019-12-25 07:00:00 +0000 UTC`},
 	{`Remind me at 7am on December 25th`, `2019-12-25 07:00:00 +0000 UTC`},
 	{`Remind me on the 25th of December at 7am`, `2019-12-25 07:00:00 +0000 UTC`},
+	{`this monday`, `2019-11-25 00:00:00 +0000 UTC`},
+	{`this sunday`, `2019-12-01 00:00:00 +0000 UTC`},
+	{`this january`, `2019-01-25 13:07:18 +0000 UTC`},
+	{`the week of december 23rd`, `2019-12-23 00:00:00 +0000 UTC`},
+	{`the last friday of december`, `2019-12-27 00:00:00 +0000 UTC`},
+	{`christmas`, `2019-12-25 00:00:00 +0000 UTC`},
+	{`last christmas`, `2018-12-25 00:00:00 +0000 UTC`},
+	{`thanksgiving`, `2019-11-28 00:00:00 +0000 UTC`},
+	{`easter`, `2020-04-12 00:00:00 +0000 UTC`},
+	{`new year's eve`, `2019-12-31 00:00:00 +0000 UTC`},
 	{`Check logs in the past 5 minutes`, `2019-11-25 13:02:18 +0000 UTC`},
 }
 
+// lenientCases are test cases for lenient dates.
+var lenientCases = []struct {
+	Input  string
+	Output string
+}{
+	{`february 28th`, `2019-02-28 13:07:18 +0000 UTC`},
+	{`february 31st`, `2019-03-03 13:07:18 +0000 UTC`},
+	{`the 31st`, `2019-12-01 13:07:18 +0000 UTC`},
+	{`the 45th`, `2019-12-15 13:07:18 +0000 UTC`},
+}
+
+// overflowCases are test cases for month overflow.
+var overflowCases = []struct {
+	Ref      time.Time
+	Overflow MonthOverflow
+	Input    string
+	Output   string
+}{
+	{time.Date(2020, 1, 31, 13, 7, 18, 0, time.UTC), Clamp, `1 month from now`, `2020-02-29 13:07:18 +0000 UTC`},
+	{time.Date(2020, 1, 31, 13, 7, 18, 0, time.UTC), Clamp, `next month`, `2020-02-29 13:07:18 +0000 UTC`},
+	{time.Date(2020, 1, 31, 13, 7, 18, 0, time.UTC), Clamp, `3 months from now`, `2020-04-30 13:07:18 +0000 UTC`},
+	{time.Date(2020, 3, 31, 13, 7, 18, 0, time.UTC), Clamp, `1 month ago`, `2020-02-29 13:07:18 +0000 UTC`},
+	{time.Date(2020, 2, 29, 13, 7, 18, 0, time.UTC), Clamp, `1 year ago`, `2019-02-28 13:07:18 +0000 UTC`},
+	{time.Date(2020, 2, 29, 13, 7, 18, 0, time.UTC), Clamp, `in 4 years`, `2024-02-29 13:07:18 +0000 UTC`},
+	{time.Date(2020, 1, 31, 13, 7, 18, 0, time.UTC), Normalize, `1 month from now`, `2020-03-02 13:07:18 +0000 UTC`},
+	{time.Date(2020, 2, 29, 13, 7, 18, 0, time.UTC), Normalize, `1 year ago`, `2019-03-01 13:07:18 +0000 UTC`},
+}
+
+// rangeCases are test cases for ranges.
+var rangeCases = []struct {
+	Input string
+	Start string
+	End   string
+}{
+	{`now`, `2019-11-25 13:07:18 +0000 UTC`, `2019-11-25 13:07:18 +0000 UTC`},
+	{`start of this month`, `2019-11-01 00:00:00 +0000 UTC`, `2019-12-01 00:00:00 +0000 UTC`},
+	{`end of last week`, `2019-11-18 00:00:00 +0000 UTC`, `2019-11-25 00:00:00 +0000 UTC`},
+	{`end of the quarter`, `2019-10-01 00:00:00 +0000 UTC`, `2020-01-01 00:00:00 +0000 UTC`},
+	{`beginning of next year`, `2020-01-01 00:00:00 +0000 UTC`, `2021-01-01 00:00:00 +0000 UTC`},
+	{`this week`, `2019-11-25 00:00:00 +0000 UTC`, `2019-12-02 00:00:00 +0000 UTC`},
+	{`last week`, `2019-11-18 00:00:00 +0000 UTC`, `2019-11-25 00:00:00 +0000 UTC`},
+	{`next week`, `2019-12-02 00:00:00 +0000 UTC`, `2019-12-09 00:00:00 +0000 UTC`},
+	{`this month`, `2019-11-01 00:00:00 +0000 UTC`, `2019-12-01 00:00:00 +0000 UTC`},
+	{`this year`, `2019-01-01 00:00:00 +0000 UTC`, `2020-01-01 00:00:00 +0000 UTC`},
+	{`week 42`, `2019-10-14 00:00:00 +0000 UTC`, `2019-10-21 00:00:00 +0000 UTC`},
+	{`2019-W42-3`, `2019-10-16 00:00:00 +0000 UTC`, `2019-10-17 00:00:00 +0000 UTC`},
+	{`the week of december 23rd`, `2018-12-17 00:00:00 +0000 UTC`, `2018-12-24 00:00:00 +0000 UTC`},
+	{`eod`, `2019-11-25 00:00:00 +0000 UTC`, `2019-11-26 00:00:00 +0000 UTC`},
+	{`end of the hour`, `2019-11-25 13:00:00 +0000 UTC`, `2019-11-25 14:00:00 +0000 UTC`},
+}
+
+// weekStartCases are test cases for the start of the week.
+var weekStartCases = []struct {
+	WeekStart time.Weekday
+	Input     string
+	Output    string
+}{
+	{time.Monday, `last week`, `2019-11-18 00:00:00 +0000 UTC`},
+	{time.Monday, `next week`, `2019-12-02 00:00:00 +0000 UTC`},
+	{time.Monday, `end of this week`, `2019-12-01 23:59:59.999999999 +0000 UTC`},
+	{time.Monday, `this sunday`, `2019-12-01 00:00:00 +0000 UTC`},
+	{time.Sunday, `last week`, `2019-11-17 00:00:00 +0000 UTC`},
+	{time.Sunday, `next week`, `2019-12-01 00:00:00 +0000 UTC`},
+	{time.Sunday, `start of this week`, `2019-11-24 00:00:00 +0000 UTC`},
+	{time.Sunday, `end of this week`, `2019-11-30 23:59:59.999999999 +0000 UTC`},
+	{time.Sunday, `this sunday`, `2019-11-24 00:00:00 +0000 UTC`},
+	{time.Sunday, `1 week ago`, `2019-11-18 00:00:00 +0000 UTC`},
+	{time.Sunday, `week 42`, `2019-10-14 00:00:00 +0000 UTC`},
+	{time.Sunday, `the week of december 23rd`, `2018-12-17 00:00:00 +0000 UTC`},
+	{time.Sunday, `sunday next week`, `2019-12-01 00:00:00 +0000 UTC`},
+	{time.Sunday, `saturday last week`, `2019-11-23 00:00:00 +0000 UTC`},
+	{time.Saturday, `start of this week`, `2019-11-23 00:00:00 +0000 UTC`},
+	{time.Saturday, `next week`, `2019-11-30 00:00:00 +0000 UTC`},
+	{time.Saturday, `this friday`, `2019-11-29 00:00:00 +0000 UTC`},
+}
+
+// Test parsing days of this week on a Saturday.
+func TestParse_thisWeekdaySaturday(t *testing.T) {
+	saturday := base.AddDate(0, 0, 5)
+
+	cases := []struct {
+		WeekStart time.Weekday
+		Input     string
+		Output    string
+	}{
+		{time.Monday, `this friday`, `2019-11-29 00:00:00 +0000 UTC`},
+		{time.Monday, `this sunday`, `2019-12-01 00:00:00 +0000 UTC`},
+		{time.Sunday, `this friday`, `2019-11-29 00:00:00 +0000 UTC`},
+		{time.Saturday, `this friday`, `2019-12-06 00:00:00 +0000 UTC`},
+		{time.Monday, `next friday`, `2019-12-06 00:00:00 +0000 UTC`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.WeekStart.String()+" "+c.Input, func(t *testing.T) {
+			v, err := Parse(c.Input, saturday, WithWeekStart(c.WeekStart))
+			assert.NoError(t, err, "parsing")
+			assert.Equal(t, c.Output, v.UTC().String())
+		})
+	}
+}
+
 // Test parsing with past direction.
 func TestParse_past(t *testing.T) {
 	for _, c := range pastCases {
@@ -258,6 +545,88 @@ func TestParse_future(t *testing.T) {
 	}
 }
 
+// Test parsing with lenient dates.
+func TestParse_lenient(t *testing.T) {
+	for _, c := range lenientCases {
+		t.Run(c.Input, func(t *testing.T) {
+			v, err := Parse(c.Input, base, WithLenientDates())
+			if err != nil {
+				assert.Equal(t, c.Output, err.Error())
+				return
+			}
+			assert.Equal(t, c.Output, v.UTC().String())
+		})
+	}
+}
+
+// Test parsing with month overflow.
+func TestParse_monthOverflow(t *testing.T) {
+	for _, c := range overflowCases {
+		t.Run(c.Input, func(t *testing.T) {
+			v, err := Parse(c.Input, c.Ref, WithMonthOverflow(c.Overflow))
+			if err != nil {
+				assert.Equal(t, c.Output, err.Error())
+				return
+			}
+			assert.Equal(t, c.Output, v.UTC().String())
+		})
+	}
+}
+
+// Test parsing with the start of the week.
+func TestParse_weekStart(t *testing.T) {
+	for _, c := range weekStartCases {
+		t.Run(c.Input, func(t *testing.T) {
+			v, err := Parse(c.Input, base, WithWeekStart(c.WeekStart))
+			if err != nil {
+				assert.Equal(t, c.Output, err.Error())
+				return
+			}
+			assert.Equal(t, c.Output, v.UTC().String())
+		})
+	}
+}
+
+// Test parsing with weekend days.
+func TestParse_weekend(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Output string
+	}{
+		{`1 business day ago`, `2019-11-24 00:00:00 +0000 UTC`},
+		{`in 5 business days`, `2019-12-02 13:07:18 +0000 UTC`},
+		{`the last business day of this month`, `2019-11-28 00:00:00 +0000 UTC`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Input, func(t *testing.T) {
+			v, err := Parse(c.Input, base, WithWeekend(time.Friday, time.Saturday))
+			assert.NoError(t, err, "parsing")
+			assert.Equal(t, c.Output, v.UTC().String())
+		})
+	}
+}
+
+// Test parsing ranges.
+func TestParseRange(t *testing.T) {
+	for _, c := range rangeCases {
+		t.Run(c.Input, func(t *testing.T) {
+			r, err := ParseRange(c.Input, base)
+			assert.NoError(t, err, "parsing")
+			assert.Equal(t, c.Start, r.Start.UTC().String())
+			assert.Equal(t, c.End, r.End.UTC().String())
+		})
+	}
+}
+
+// Test parsing ISO week ranges with another week start.
+func TestParseRange_isoWeek(t *testing.T) {
+	r, err := ParseRange(`the week of december 23rd`, base, WithWeekStart(time.Sunday))
+	assert.NoError(t, err, "parsing")
+	assert.Equal(t, `2018-12-17 00:00:00 +0000 UTC`, r.Start.String())
+	assert.Equal(t, `2018-12-24 00:00:00 +0000 UTC`, r.End.String())
+}
+
 // Benchmark parsing.
 func BenchmarkParse(b *testing.B) {
 	b.SetBytes(1)
@@ -0,0 +1,701 @@
+package naturaldate
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// Frequency is the unit of time between occurrences of a recurrence.
+type Frequency int
+
+// Frequencies available.
+const (
+	Minutely Frequency = iota + 1
+	Hourly
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+// Recurrence is a schedule of times, such as "every monday at 9am".
+type Recurrence struct {
+	// Frequency is the unit of time between occurrences.
+	Frequency Frequency
+
+	// Interval is the number of frequency units between occurrences, such as
+	// 2 for "every other day".
+	Interval int
+
+	// Start is the earliest occurrence. Its clock is the time of day of each
+	// occurrence, and intervals are counted from it.
+	Start time.Time
+
+	// WeekStart is the first day of the week, used to count weekly intervals.
+	WeekStart time.Weekday
+
+	// Weekdays limits occurrences to the given days of the week.
+	Weekdays []time.Weekday
+
+	// NthWeekdays limits occurrences to the given days of the week within
+	// the month, such as the 4th thursday.
+	NthWeekdays []NthWeekday
+
+	// MonthDays limits occurrences to the given days of the month.
+	MonthDays []int
+
+	// Months limits occurrences to the given months.
+	Months []time.Month
+
+	// Hours is the hours of the day of each occurrence, limiting occurrences
+	// more frequent than hourly.
+	Hours []int
+
+	// Minutes is the minutes of the hour of each occurrence, limiting
+	// occurrences more frequent than hourly.
+	Minutes []int
+
+	// DayEnd is the latest time of day of occurrences as a duration from
+	// midnight, unless zero, such as 5pm for "every 15 minutes from 9am to
+	// 5pm", which occurs at 5pm but not at 5:15pm.
+	DayEnd time.Duration
+
+	// Until is the latest occurrence, unless zero.
+	Until time.Time
+
+	// Count is the number of occurrences from the start, unless zero.
+	Count int
+}
+
+// NthWeekday is the nth day of the week within a month, counting from the
+// end of the month when N is negative, such as -1 for the last monday.
+type NthWeekday struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// maxDays is the number of days searched for an occurrence, the 400 year
+// cycle of the Gregorian calendar.
+const maxDays = 146097
+
+// ParseRecurrence parses a recurring schedule such as "every monday at 9am",
+// starting on the day of the reference time.
+func ParseRecurrence(s string, ref time.Time, options ...Option) (Recurrence, error) {
+	r := Recurrence{Interval: 1}
+
+	p, err := parse(s, ref, append(options, func(p *parser) {
+		p.recurrence = &r
+	})...)
+	if err != nil {
+		return Recurrence{}, err
+	}
+
+	r.WeekStart = p.weekStart
+	r.Start = truncateDay(ref)
+
+	switch len(p.times) {
+	case 0:
+	case 1:
+		r.Start = p.times[0]
+	default:
+		if err := r.setTimes(p.times); err != nil {
+			return Recurrence{}, err
+		}
+	}
+
+	if !r.minutesAlign() {
+		return Recurrence{}, errors.New("minutes never coincide with the interval")
+	}
+
+	if !p.hourRangeEnd.IsZero() {
+		if err := r.endHourRange(p.hourRangeEnd); err != nil {
+			return Recurrence{}, err
+		}
+	}
+
+	if p.until {
+		if r.Count > 0 {
+			return Recurrence{}, errors.New("recurrence cannot end both after a count and on a date")
+		}
+		switch {
+		case p.clock:
+			r.Until = p.t
+		case p.period != periodNone:
+			r.Until = endOf(p.t, p.period, p.weekStart)
+		default:
+			r.Until = endOf(p.t, periodDay, p.weekStart)
+		}
+	}
+
+	return r, nil
+}
+
+// setTimes sets the hours and minutes of several times of day, which must
+// be every combination of their hours and minutes.
+func (r *Recurrence) setTimes(times []time.Time) error {
+	pairs := make(map[[2]int]bool)
+	for _, t := range times {
+		r.Hours = addInt(r.Hours, t.Hour())
+		r.Minutes = addInt(r.Minutes, t.Minute())
+		pairs[[2]int{t.Hour(), t.Minute()}] = true
+	}
+
+	if len(r.Hours)*len(r.Minutes) != len(pairs) {
+		return errors.New("times must share the same minutes")
+	}
+
+	y, m, d := r.Start.Date()
+	r.Start = time.Date(y, m, d, 0, 0, times[0].Second(), 0, r.Start.Location())
+	return nil
+}
+
+// addTime adds the time parsed as a time of day of the recurrence.
+func (p *parser) addTime() {
+	p.times = append(p.times, p.t)
+}
+
+// setHourRange adds the hours of the recurrence from the given hour until
+// the hour parsed.
+func (p *parser) setHourRange(from int) {
+	to := p.t.Hour()
+	if to < from {
+		p.errorf("invalid hour range %d to %d", from, to)
+		return
+	}
+	for h := from; h <= to; h++ {
+		p.recurrence.Hours = addInt(p.recurrence.Hours, h)
+	}
+	p.hourRangeEnd = p.t
+}
+
+// endHourRange ends an hour range at time end. Occurrences within the last
+// hour which fall after the end are excluded, such as 5:15pm for "every 15
+// minutes from 9am to 5pm", removing the hour when none remain.
+func (r *Recurrence) endHourRange(end time.Time) error {
+	minutes := r.hourMinutes(end.Hour())
+	if len(minutes) == 0 || minutes[len(minutes)-1] <= end.Minute() {
+		return nil
+	}
+
+	if minutes[0] <= end.Minute() {
+		r.DayEnd = time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute
+		return nil
+	}
+
+	var hours []int
+	for _, h := range r.Hours {
+		if h != end.Hour() {
+			hours = append(hours, h)
+		}
+	}
+
+	if len(hours) == 0 {
+		return errors.New("hour range contains no occurrences")
+	}
+
+	r.Hours = hours
+	return nil
+}
+
+// hourMinutes returns the minutes of occurrences within the given hour.
+func (r Recurrence) hourMinutes(hour int) (v []int) {
+	if r.Frequency != Minutely {
+		return r.minutes()
+	}
+
+	y, m, d := r.Start.Date()
+	step := time.Minute * time.Duration(r.interval())
+	for min := 0; min < 60; min++ {
+		t := time.Date(y, m, d, hour, min, r.Start.Second(), 0, r.Start.Location())
+		if (len(r.Minutes) == 0 || hasInt(r.Minutes, min)) && t.Sub(r.Start)%step == 0 {
+			v = append(v, min)
+		}
+	}
+
+	return
+}
+
+// addMinute adds a minute of the hour to the recurrence.
+func (p *parser) addMinute(min int) {
+	if min < 0 || min > 59 {
+		p.errorf("invalid minute %d", min)
+		return
+	}
+	p.recurrence.Minutes = addInt(p.recurrence.Minutes, min)
+}
+
+// setUntil marks the expression which follows as the end of the recurrence.
+func (p *parser) setUntil() {
+	p.until = true
+	p.clock = false
+	p.period = periodNone
+	p.year = 0
+	p.direction = 1
+}
+
+// setUntilMonth ends the recurrence with the month parsed, in the year parsed,
+// otherwise the current or following such month.
+func (p *parser) setUntilMonth() {
+	y := p.year
+	if y == 0 {
+		y = p.t.Year()
+		if p.month < p.t.Month() {
+			y++
+		}
+	}
+	p.t = time.Date(y, p.month, 1, 0, 0, 0, 0, p.t.Location())
+	p.period = periodMonth
+}
+
+// addInt adds n to the sorted values, unless present.
+func addInt(values []int, n int) []int {
+	i := sort.SearchInts(values, n)
+	if i < len(values) && values[i] == n {
+		return values
+	}
+	values = append(values, 0)
+	copy(values[i+1:], values[i:])
+	values[i] = n
+	return values
+}
+
+// Next returns the first occurrence after time t, or the zero time when
+// there are no more occurrences.
+func (r Recurrence) Next(t time.Time) (v time.Time) {
+	r.each(t, func(t time.Time) bool {
+		v = t
+		return false
+	})
+	return
+}
+
+// each calls fn with the occurrences after time t in order, until fn returns
+// false or there are no more occurrences.
+func (r Recurrence) each(t time.Time, fn func(time.Time) bool) {
+	v, n := t, 0
+
+	if r.Count > 0 {
+		v = r.Start.Add(-time.Nanosecond)
+		for ; n < r.Count; n++ {
+			next := r.next(v)
+			if next.IsZero() || next.After(t) {
+				break
+			}
+			v = next
+		}
+	}
+
+	for r.Count == 0 || n < r.Count {
+		v = r.next(v)
+		if v.IsZero() || !fn(v) {
+			return
+		}
+		n++
+	}
+}
+
+// next returns the first occurrence after time t, disregarding the count.
+// Only the 400 year calendar cycle is searched, so schedules which never
+// occur end rather than looping forever.
+func (r Recurrence) next(t time.Time) time.Time {
+	if !r.minutesAlign() {
+		return time.Time{}
+	}
+
+	t = t.In(r.Start.Location())
+	if t.Before(r.Start) {
+		t = r.Start.Add(-time.Nanosecond)
+	}
+
+	day := truncateDay(t)
+	for i := 0; i < maxDays; i++ {
+		if !r.Until.IsZero() && day.After(r.Until) {
+			break
+		}
+
+		if r.matchDay(day) {
+			if v, ok := r.first(day, t); ok {
+				if !r.Until.IsZero() && v.After(r.Until) {
+					return time.Time{}
+				}
+				return v
+			}
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}
+}
+
+// Between returns the occurrences from time a until time b, where b is
+// exclusive.
+func (r Recurrence) Between(a, b time.Time) (v []time.Time) {
+	r.each(a.Add(-time.Nanosecond), func(t time.Time) bool {
+		if !t.Before(b) {
+			return false
+		}
+		v = append(v, t)
+		return true
+	})
+	return
+}
+
+// matchDay returns true if occurrences may fall on the given day.
+func (r Recurrence) matchDay(day time.Time) bool {
+	if len(r.Weekdays) > 0 && !hasWeekday(r.Weekdays, day.Weekday()) {
+		return false
+	}
+
+	if len(r.NthWeekdays) > 0 && !hasNthWeekday(r.NthWeekdays, day) {
+		return false
+	}
+
+	if len(r.MonthDays) > 0 && !hasMonthDay(r.MonthDays, day) {
+		return false
+	}
+
+	if len(r.Months) > 0 && !hasMonth(r.Months, day.Month()) {
+		return false
+	}
+
+	start := r.Start
+	interval := r.interval()
+
+	switch r.Frequency {
+	case Daily:
+		return daysBetween(start, day)%interval == 0
+	case Weekly:
+		if len(r.Weekdays) == 0 && day.Weekday() != start.Weekday() {
+			return false
+		}
+		weeks := daysBetween(startOf(start, periodWeek, r.WeekStart), startOf(day, periodWeek, r.WeekStart)) / 7
+		return weeks%interval == 0
+	case Monthly:
+		if len(r.Weekdays) == 0 && len(r.NthWeekdays) == 0 && len(r.MonthDays) == 0 && day.Day() != start.Day() {
+			return false
+		}
+		months := (day.Year()-start.Year())*12 + int(day.Month()-start.Month())
+		return months%interval == 0
+	case Yearly:
+		if len(r.Weekdays) == 0 && len(r.NthWeekdays) == 0 && len(r.Months) == 0 && day.Month() != start.Month() {
+			return false
+		}
+		if len(r.Weekdays) == 0 && len(r.NthWeekdays) == 0 && len(r.MonthDays) == 0 && day.Day() != start.Day() {
+			return false
+		}
+		return (day.Year()-start.Year())%interval == 0
+	}
+
+	return true
+}
+
+// first returns the first occurrence on the given day after time t, if any.
+func (r Recurrence) first(day, t time.Time) (time.Time, bool) {
+	start := r.Start
+	end := day.AddDate(0, 0, 1)
+
+	from := day
+	if !t.Before(from) {
+		from = t.Add(time.Nanosecond)
+	}
+	if from.Before(start) {
+		from = start
+	}
+
+	switch r.Frequency {
+	case Minutely:
+		step := time.Minute * time.Duration(r.interval())
+		for v := start.Add((from.Sub(start) + step - 1) / step * step); v.Before(end); v = v.Add(step) {
+			if r.matchHour(v.Hour()) && (len(r.Minutes) == 0 || hasInt(r.Minutes, v.Minute())) && r.beforeDayEnd(v) {
+				return v, true
+			}
+		}
+	case Hourly:
+		step := time.Hour * time.Duration(r.interval())
+		for v := start.Add(from.Sub(start) / step * step); v.Before(end); v = v.Add(step) {
+			if !r.matchHour(v.Hour()) {
+				continue
+			}
+			for _, min := range r.minutes() {
+				c := time.Date(v.Year(), v.Month(), v.Day(), v.Hour(), min, start.Second(), 0, start.Location())
+				if !c.Before(from) && r.beforeDayEnd(c) {
+					return c, true
+				}
+			}
+		}
+	default:
+		y, m, d := day.Date()
+		hours := r.Hours
+		if len(hours) == 0 {
+			hours = []int{start.Hour()}
+		}
+		for _, hour := range hours {
+			for _, min := range r.minutes() {
+				c := time.Date(y, m, d, hour, min, start.Second(), 0, start.Location())
+				if !c.Before(from) && r.beforeDayEnd(c) {
+					return c, true
+				}
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// minutesAlign returns true if the minutes of a minutely recurrence coincide
+// with its interval in some hour, which "every 2 minutes at 5 minutes past the
+// hour" never does.
+func (r Recurrence) minutesAlign() bool {
+	if r.Frequency != Minutely || len(r.Minutes) == 0 {
+		return true
+	}
+
+	n := gcd(r.interval(), 24*60)
+	start := r.Start.Hour()*60 + r.Start.Minute()
+	for hour := 0; hour < 24; hour++ {
+		if !r.matchHour(hour) {
+			continue
+		}
+		for _, min := range r.Minutes {
+			if ((hour*60+min-start)%n+n)%n == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// matchHour returns true if occurrences may fall in the given hour.
+func (r Recurrence) matchHour(hour int) bool {
+	return len(r.Hours) == 0 || hasInt(r.Hours, hour)
+}
+
+// beforeDayEnd returns true if the time of day of t is not after the end of
+// day of the recurrence.
+func (r Recurrence) beforeDayEnd(t time.Time) bool {
+	if r.DayEnd == 0 {
+		return true
+	}
+	hour, min, sec := t.Clock()
+	return time.Duration(hour)*time.Hour+time.Duration(min)*time.Minute+time.Duration(sec)*time.Second <= r.DayEnd
+}
+
+// minutes returns the minutes of occurrences within an hour.
+func (r Recurrence) minutes() []int {
+	if len(r.Minutes) == 0 {
+		return []int{r.Start.Minute()}
+	}
+	return r.Minutes
+}
+
+// interval returns the interval, which is at least one.
+func (r Recurrence) interval() int {
+	if r.Interval < 1 {
+		return 1
+	}
+	return r.Interval
+}
+
+// addWeekday adds a day of the week to the recurrence.
+func (r *Recurrence) addWeekday(day time.Weekday) {
+	if !hasWeekday(r.Weekdays, day) {
+		r.Weekdays = append(r.Weekdays, day)
+	}
+}
+
+// addMonth adds a month to the recurrence.
+func (r *Recurrence) addMonth(month time.Month) {
+	if !hasMonth(r.Months, month) {
+		r.Months = append(r.Months, month)
+	}
+}
+
+// hasWeekday returns true if the given day is in days.
+func hasWeekday(days []time.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// hasInt returns true if n is in values.
+func hasInt(values []int, n int) bool {
+	for _, v := range values {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
+
+// hasMonth returns true if the given month is in months.
+func hasMonth(months []time.Month, month time.Month) bool {
+	for _, m := range months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+// hasNthWeekday returns true if the day of time t is in days.
+func hasNthWeekday(days []NthWeekday, t time.Time) bool {
+	for _, d := range days {
+		if v, ok := nthWeekday(t, d.N, d.Weekday); ok && v.Day() == t.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasMonthDay returns true if the day of time t is in days, where negative
+// days count from the end of the month.
+func hasMonthDay(days []int, t time.Time) bool {
+	n := daysIn(t.Year(), t.Month())
+	for _, d := range days {
+		if d == t.Day() || d < 0 && n+d+1 == t.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// daysBetween returns the number of calendar days from time a to time b.
+func daysBetween(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	d := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC))
+	return int(d / day)
+}
+
+// businessWeekdays returns the days of the week which are not on the
+// weekend.
+func (p *parser) businessWeekdays() (days []time.Weekday) {
+	for d := time.Monday; d <= time.Saturday; d++ {
+		if !hasWeekday(p.weekend, d) {
+			days = append(days, d)
+		}
+	}
+	if !hasWeekday(p.weekend, time.Sunday) {
+		days = append(days, time.Sunday)
+	}
+	return
+}
+
+// setInterval sets the number of frequency units between occurrences.
+func (p *parser) setInterval(n int) {
+	if n < 1 {
+		p.errorf("invalid interval %d", n)
+		return
+	}
+	p.recurrence.Interval = n
+}
+
+// setMonthDay adds a day of the month to the recurrence.
+func (p *parser) setMonthDay(day int) {
+	if day < 1 || day > 31 {
+		p.errorf("invalid day %d", day)
+		return
+	}
+	p.recurrence.MonthDays = append(p.recurrence.MonthDays, day)
+}
+
+// excludeWeekday removes a day of the week from the recurrence.
+func (p *parser) excludeWeekday(day time.Weekday) {
+	r := p.recurrence
+	if len(r.Weekdays) == 0 {
+		switch r.Frequency {
+		case Minutely, Hourly, Daily:
+			r.Weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday}
+		case Weekly:
+			r.Weekdays = []time.Weekday{p.t.Weekday()}
+		default:
+			p.errorf("cannot exclude %s from %s recurrence", day, r.Frequency)
+			return
+		}
+	}
+
+	var days []time.Weekday
+	for _, d := range r.Weekdays {
+		if d != day {
+			days = append(days, d)
+		}
+	}
+
+	if len(days) == 0 {
+		p.errorf("recurrence excludes every day")
+		return
+	}
+
+	r.Weekdays = days
+}
+
+// excludeMonth removes a month from the recurrence.
+func (p *parser) excludeMonth(month time.Month) {
+	r := p.recurrence
+	if len(r.Months) == 0 {
+		if r.Frequency == Yearly {
+			r.Months = []time.Month{p.t.Month()}
+		} else {
+			for m := time.January; m <= time.December; m++ {
+				r.Months = append(r.Months, m)
+			}
+		}
+	}
+
+	var months []time.Month
+	for _, m := range r.Months {
+		if m != month {
+			months = append(months, m)
+		}
+	}
+
+	if len(months) == 0 {
+		p.errorf("recurrence excludes every month")
+		return
+	}
+
+	r.Months = months
+}
+
+// setCount sets the number of occurrences.
+func (p *parser) setCount(n int) {
+	if n < 1 {
+		p.errorf("invalid count %d", n)
+		return
+	}
+	p.recurrence.Count = n
+}
+
+// String returns the frequency in lowercase, such as "daily".
+func (f Frequency) String() string {
+	switch f {
+	case Minutely:
+		return "minutely"
+	case Hourly:
+		return "hourly"
+	case Daily:
+		return "daily"
+	case Weekly:
+		return "weekly"
+	case Monthly:
+		return "monthly"
+	case Yearly:
+		return "yearly"
+	default:
+		return "unknown"
+	}
+}
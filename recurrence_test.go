@@ -0,0 +1,319 @@
+package naturaldate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tj/assert"
+)
+
+var recurrenceCases = []struct {
+	Input  string
+	Output []string
+}{
+	{`every monday at 9am`, []string{
+		`2019-12-02 09:00:00 +0000 UTC`,
+		`2019-12-09 09:00:00 +0000 UTC`,
+		`2019-12-16 09:00:00 +0000 UTC`,
+	}},
+	{`every 2 weeks on friday`, []string{
+		`2019-11-29 00:00:00 +0000 UTC`,
+		`2019-12-13 00:00:00 +0000 UTC`,
+		`2019-12-27 00:00:00 +0000 UTC`,
+	}},
+	{`every weekday at 8:30`, []string{
+		`2019-11-26 08:30:00 +0000 UTC`,
+		`2019-11-27 08:30:00 +0000 UTC`,
+		`2019-11-28 08:30:00 +0000 UTC`,
+	}},
+	{`every other day`, []string{
+		`2019-11-27 00:00:00 +0000 UTC`,
+		`2019-11-29 00:00:00 +0000 UTC`,
+		`2019-12-01 00:00:00 +0000 UTC`,
+	}},
+	{`daily at noon`, []string{
+		`2019-11-26 12:00:00 +0000 UTC`,
+		`2019-11-27 12:00:00 +0000 UTC`,
+		`2019-11-28 12:00:00 +0000 UTC`,
+	}},
+	{`every 15 minutes`, []string{
+		`2019-11-25 13:15:00 +0000 UTC`,
+		`2019-11-25 13:30:00 +0000 UTC`,
+		`2019-11-25 13:45:00 +0000 UTC`,
+	}},
+	{`hourly`, []string{
+		`2019-11-25 14:00:00 +0000 UTC`,
+		`2019-11-25 15:00:00 +0000 UTC`,
+		`2019-11-25 16:00:00 +0000 UTC`,
+	}},
+	{`every monday and thursday at 5pm`, []string{
+		`2019-11-25 17:00:00 +0000 UTC`,
+		`2019-11-28 17:00:00 +0000 UTC`,
+		`2019-12-02 17:00:00 +0000 UTC`,
+	}},
+	{`weekly on friday`, []string{
+		`2019-11-29 00:00:00 +0000 UTC`,
+		`2019-12-06 00:00:00 +0000 UTC`,
+		`2019-12-13 00:00:00 +0000 UTC`,
+	}},
+	{`every weekend`, []string{
+		`2019-11-30 00:00:00 +0000 UTC`,
+		`2019-12-01 00:00:00 +0000 UTC`,
+		`2019-12-07 00:00:00 +0000 UTC`,
+	}},
+	{`every month on the 1st and 15th at 10am`, []string{
+		`2019-12-01 10:00:00 +0000 UTC`,
+		`2019-12-15 10:00:00 +0000 UTC`,
+		`2020-01-01 10:00:00 +0000 UTC`,
+	}},
+	{`every month on the 31st`, []string{
+		`2019-12-31 00:00:00 +0000 UTC`,
+		`2020-01-31 00:00:00 +0000 UTC`,
+		`2020-03-31 00:00:00 +0000 UTC`,
+	}},
+	{`every year`, []string{
+		`2020-11-25 00:00:00 +0000 UTC`,
+		`2021-11-25 00:00:00 +0000 UTC`,
+		`2022-11-25 00:00:00 +0000 UTC`,
+	}},
+	{`every weekday except fridays`, []string{
+		`2019-11-26 00:00:00 +0000 UTC`,
+		`2019-11-27 00:00:00 +0000 UTC`,
+		`2019-11-28 00:00:00 +0000 UTC`,
+		`2019-12-02 00:00:00 +0000 UTC`,
+	}},
+	{`every day except weekends at 9am`, []string{
+		`2019-11-26 09:00:00 +0000 UTC`,
+		`2019-11-27 09:00:00 +0000 UTC`,
+		`2019-11-28 09:00:00 +0000 UTC`,
+		`2019-11-29 09:00:00 +0000 UTC`,
+		`2019-12-02 09:00:00 +0000 UTC`,
+	}},
+	{`every month on the 1st except in january and february`, []string{
+		`2019-12-01 00:00:00 +0000 UTC`,
+		`2020-03-01 00:00:00 +0000 UTC`,
+		`2020-04-01 00:00:00 +0000 UTC`,
+	}},
+	{`every monday at 9am until december 10th`, []string{
+		`2019-12-02 09:00:00 +0000 UTC`,
+		`2019-12-09 09:00:00 +0000 UTC`,
+		`0001-01-01 00:00:00 +0000 UTC`,
+	}},
+	{`every monday until december 9th`, []string{
+		`2019-12-02 00:00:00 +0000 UTC`,
+		`2019-12-09 00:00:00 +0000 UTC`,
+		`0001-01-01 00:00:00 +0000 UTC`,
+	}},
+	{`every 3 days for 3 times`, []string{
+		`2019-11-28 00:00:00 +0000 UTC`,
+		`2019-12-01 00:00:00 +0000 UTC`,
+		`0001-01-01 00:00:00 +0000 UTC`,
+	}},
+	{`every day at 9am and 5pm`, []string{
+		`2019-11-25 17:00:00 +0000 UTC`,
+		`2019-11-26 09:00:00 +0000 UTC`,
+		`2019-11-26 17:00:00 +0000 UTC`,
+	}},
+	{`every 15 minutes on weekdays from 9am to 5pm`, []string{
+		`2019-11-25 13:15:00 +0000 UTC`,
+		`2019-11-25 13:30:00 +0000 UTC`,
+		`2019-11-25 13:45:00 +0000 UTC`,
+	}},
+	{`every 2 hours between 8am and noon`, []string{
+		`2019-11-26 08:00:00 +0000 UTC`,
+		`2019-11-26 10:00:00 +0000 UTC`,
+		`2019-11-26 12:00:00 +0000 UTC`,
+	}},
+	{`every hour at 15 and 45 minutes past the hour`, []string{
+		`2019-11-25 13:15:00 +0000 UTC`,
+		`2019-11-25 13:45:00 +0000 UTC`,
+		`2019-11-25 14:15:00 +0000 UTC`,
+	}},
+	{`every day in january at 9am`, []string{
+		`2020-01-01 09:00:00 +0000 UTC`,
+		`2020-01-02 09:00:00 +0000 UTC`,
+		`2020-01-03 09:00:00 +0000 UTC`,
+	}},
+	{`every day at 9am and 5:30pm`, []string{`times must share the same minutes`}},
+	{`every hour from 5pm to 9am`, []string{`invalid hour range 17 to 9`}},
+	{`every 0 days`, []string{`invalid interval 0`}},
+	{`every 3 days 0 times`, []string{`invalid count 0`}},
+	{`every day for 3 times until december 1st`, []string{`recurrence cannot end both after a count and on a date`}},
+	{`every monday except mondays`, []string{`recurrence excludes every day`}},
+	{`every month except mondays`, []string{`cannot exclude Monday from monthly recurrence`}},
+	{`yearly except november`, []string{`recurrence excludes every month`}},
+}
+
+// Test parsing recurrences.
+func TestParseRecurrence(t *testing.T) {
+	for _, c := range recurrenceCases {
+		t.Run(c.Input, func(t *testing.T) {
+			r, err := ParseRecurrence(c.Input, base)
+			if err != nil {
+				assert.Equal(t, c.Output, []string{err.Error()})
+				return
+			}
+
+			var out []string
+			for v := base; len(out) < len(c.Output); {
+				v = r.Next(v)
+				out = append(out, v.UTC().String())
+				if v.IsZero() {
+					break
+				}
+			}
+			assert.Equal(t, c.Output, out)
+		})
+	}
+}
+
+// Test parsing the end of recurrences.
+func TestParseRecurrence_until(t *testing.T) {
+	cases := []struct {
+		Input string
+		Until string
+	}{
+		{`every monday until march`, `2020-03-31 23:59:59.999999999 +0000 UTC`},
+		{`every monday until november`, `2019-11-30 23:59:59.999999999 +0000 UTC`},
+		{`every monday until march 2021`, `2021-03-31 23:59:59.999999999 +0000 UTC`},
+		{`every monday until march 5th`, `2020-03-05 23:59:59.999999999 +0000 UTC`},
+		{`every monday until december 10th at 9am`, `2019-12-10 09:00:00 +0000 UTC`},
+		{`every monday until next week`, `2019-12-08 23:59:59.999999999 +0000 UTC`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Input, func(t *testing.T) {
+			r, err := ParseRecurrence(c.Input, base)
+			assert.NoError(t, err, "parsing")
+			assert.Equal(t, c.Until, r.Until.String())
+		})
+	}
+}
+
+// Test parsing the hours of recurrences within a time range.
+func TestParseRecurrence_hourRange(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Hours  []int
+		DayEnd time.Duration
+	}{
+		{`every 15 minutes from 9am to 5pm`, []int{9, 10, 11, 12, 13, 14, 15, 16, 17}, 17 * time.Hour},
+		{`every 15 minutes from 9am to 5:45pm`, []int{9, 10, 11, 12, 13, 14, 15, 16, 17}, 0},
+		{`every 20 minutes between 8am and 10:30am`, []int{8, 9, 10}, 10*time.Hour + 30*time.Minute},
+		{`every hour from 9am to 5pm`, []int{9, 10, 11, 12, 13, 14, 15, 16, 17}, 0},
+		{`every hour from 9am to 5pm at 30 minutes past the hour`, []int{9, 10, 11, 12, 13, 14, 15, 16}, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Input, func(t *testing.T) {
+			r, err := ParseRecurrence(c.Input, base)
+			assert.NoError(t, err, "parsing")
+			assert.Equal(t, c.Hours, r.Hours)
+			assert.Equal(t, c.DayEnd, r.DayEnd)
+		})
+	}
+
+	r, err := ParseRecurrence(`every 15 minutes from 9am to 5pm`, base)
+	assert.NoError(t, err, "parsing")
+
+	day := truncateDay(base)
+	var out []string
+	for _, v := range r.Between(day.Add(16*time.Hour+50*time.Minute), day.Add(33*time.Hour+20*time.Minute)) {
+		out = append(out, v.String())
+	}
+
+	assert.Equal(t, []string{
+		`2019-11-25 17:00:00 +0000 UTC`,
+		`2019-11-26 09:00:00 +0000 UTC`,
+		`2019-11-26 09:15:00 +0000 UTC`,
+	}, out)
+	assert.Equal(t, `FREQ=MINUTELY;INTERVAL=15;BYHOUR=9,10,11,12,13,14,15,16`, r.RRule())
+
+	_, err = r.Cron()
+	assert.EqualError(t, err, `end of day within an hour is not representable`)
+
+	_, err = ParseRecurrence(`every hour from 9am to 9am at 30 minutes past the hour`, base)
+	assert.EqualError(t, err, `hour range contains no occurrences`)
+}
+
+// Test recurrences whose minutes never coincide with their interval.
+func TestRecurrence_unaligned(t *testing.T) {
+	_, err := ParseRecurrence(`every 2 minutes at 5 minutes past the hour`, base)
+	assert.EqualError(t, err, `minutes never coincide with the interval`)
+
+	_, err = ParseRRule(`FREQ=MINUTELY;INTERVAL=2;BYMINUTE=5`, truncateDay(base))
+	assert.EqualError(t, err, `BYMINUTE never coincides with INTERVAL`)
+
+	r, err := ParseRecurrence(`every 7 minutes at 5 minutes past the hour`, base)
+	assert.NoError(t, err, "parsing")
+	assert.Equal(t, `2019-11-25 18:05:00 +0000 UTC`, r.Next(base).String())
+
+	done := make(chan time.Time)
+	go func() {
+		r := Recurrence{Frequency: Minutely, Interval: 2, Start: truncateDay(base), Minutes: []int{5}}
+		done <- r.Next(base)
+	}()
+
+	select {
+	case v := <-done:
+		assert.True(t, v.IsZero())
+	case <-time.After(time.Second):
+		t.Fatal("timed out")
+	}
+}
+
+// Test parsing invalid recurrences.
+func TestParseRecurrence_invalid(t *testing.T) {
+	_, err := ParseRecurrence(`tomorrow at 9am`, base)
+	assert.Error(t, err)
+}
+
+// Test occurrences of recurrences with a count.
+func TestRecurrence_count(t *testing.T) {
+	r, err := ParseRecurrence(`every 3 days for 10 times`, base)
+	assert.NoError(t, err, "parsing")
+
+	v := r.Between(base.AddDate(-1, 0, 0), base.AddDate(1, 0, 0))
+	assert.Len(t, v, 10)
+	assert.Equal(t, `2019-11-25 00:00:00 +0000 UTC`, v[0].UTC().String())
+	assert.Equal(t, `2019-12-22 00:00:00 +0000 UTC`, v[9].UTC().String())
+}
+
+// Test recurrences which never occur.
+func TestRecurrence_empty(t *testing.T) {
+	r, err := ParseRecurrence(`every month on the 31st except january, march, may, july, august, october and december`, base)
+	assert.NoError(t, err, "parsing")
+	assert.True(t, r.Next(base).IsZero())
+	assert.Empty(t, r.Between(base, base.AddDate(10, 0, 0)))
+}
+
+// Test occurrences between two times.
+func TestRecurrence_Between(t *testing.T) {
+	r, err := ParseRecurrence(`every monday at 9am`, base)
+	assert.NoError(t, err, "parsing")
+
+	start := time.Date(2019, time.December, 2, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2019, time.December, 30, 9, 0, 0, 0, time.UTC)
+
+	var out []string
+	for _, v := range r.Between(start, end) {
+		out = append(out, v.UTC().String())
+	}
+
+	assert.Equal(t, []string{
+		`2019-12-02 09:00:00 +0000 UTC`,
+		`2019-12-09 09:00:00 +0000 UTC`,
+		`2019-12-16 09:00:00 +0000 UTC`,
+		`2019-12-23 09:00:00 +0000 UTC`,
+	}, out)
+
+	r, err = ParseRecurrence(`every minute for 20000 times`, base)
+	assert.NoError(t, err, "parsing")
+
+	v := r.Between(r.Start, r.Start.AddDate(0, 3, 0))
+	assert.Len(t, v, 20000)
+	assert.Equal(t, `2019-12-08 21:19:00 +0000 UTC`, v[len(v)-1].String())
+
+	v = r.Between(r.Start.AddDate(0, 0, 7), r.Start.AddDate(0, 3, 0))
+	assert.Len(t, v, 20000-7*24*60)
+}
@@ -0,0 +1,289 @@
+package naturaldate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// weekdayCodes are the iCalendar codes for days of the week.
+var weekdayCodes = map[time.Weekday]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+// RRule returns the recurrence as an RFC 5545 recurrence rule, such as
+// "FREQ=WEEKLY;BYDAY=MO". The start of the recurrence is not included, see
+// VEvent for a complete event. Rules cannot end within an hour, so the last
+// of several hours of a recurrence with a DayEnd is omitted, such as 5pm for
+// "every 15 minutes from 9am to 5pm".
+func (r Recurrence) RRule() string {
+	parts := []string{"FREQ=" + strings.ToUpper(r.Frequency.String())}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	months := r.Months
+	if r.Frequency == Yearly && len(months) == 0 && len(r.Weekdays) == 0 && len(r.NthWeekdays) == 0 && len(r.MonthDays) > 0 {
+		months = []time.Month{r.Start.Month()}
+	}
+
+	if len(months) > 0 {
+		var v []string
+		for _, m := range months {
+			v = append(v, strconv.Itoa(int(m)))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(v, ","))
+	}
+
+	if len(r.MonthDays) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.MonthDays))
+	}
+
+	if len(r.Weekdays) > 0 || len(r.NthWeekdays) > 0 {
+		var v []string
+		for _, d := range r.Weekdays {
+			v = append(v, weekdayCodes[d])
+		}
+		for _, d := range r.NthWeekdays {
+			v = append(v, strconv.Itoa(d.N)+weekdayCodes[d.Weekday])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(v, ","))
+	}
+
+	if len(r.Hours) > 0 {
+		hours := r.Hours
+		if r.DayEnd != 0 && len(hours) > 1 {
+			hours = nil
+			for _, h := range r.Hours {
+				if time.Duration(h)*time.Hour < r.DayEnd.Truncate(time.Hour) {
+					hours = append(hours, h)
+				}
+			}
+		}
+		parts = append(parts, "BYHOUR="+joinInts(hours))
+	}
+
+	if len(r.Minutes) > 0 {
+		parts = append(parts, "BYMINUTE="+joinInts(r.Minutes))
+	}
+
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayCodes[r.WeekStart])
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// ParseRRule parses an RFC 5545 recurrence rule such as "FREQ=WEEKLY;BYDAY=MO",
+// with the "RRULE:" prefix being optional. The start is the DTSTART of the
+// rule, and the time zone of dates without one.
+func ParseRRule(s string, start time.Time) (Recurrence, error) {
+	r := Recurrence{
+		Interval:  1,
+		Start:     start,
+		WeekStart: time.Monday,
+	}
+
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(strings.ToUpper(s), "RRULE:") {
+		s = s[len("RRULE:"):]
+	}
+
+	for _, part := range strings.Split(s, ";") {
+		i := strings.Index(part, "=")
+		if i == -1 {
+			return Recurrence{}, fmt.Errorf("invalid rule part %q", part)
+		}
+
+		name, value := strings.ToUpper(part[:i]), strings.ToUpper(part[i+1:])
+		var err error
+
+		switch name {
+		case "FREQ":
+			r.Frequency, err = parseFrequency(value)
+		case "INTERVAL":
+			r.Interval, err = parsePositive(value)
+		case "COUNT":
+			r.Count, err = parsePositive(value)
+		case "UNTIL":
+			r.Until, err = parseRRuleDate(value, start.Location())
+		case "BYMONTH":
+			for _, v := range strings.Split(value, ",") {
+				n, e := strconv.Atoi(v)
+				if e != nil || n < 1 || n > 12 {
+					err = fmt.Errorf("invalid month %q", v)
+					break
+				}
+				r.Months = append(r.Months, time.Month(n))
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				n, e := strconv.Atoi(v)
+				if e != nil || n == 0 || n < -31 || n > 31 {
+					err = fmt.Errorf("invalid day %q", v)
+					break
+				}
+				r.MonthDays = append(r.MonthDays, n)
+			}
+		case "BYHOUR":
+			r.Hours, err = parseInts(value, 0, 23)
+		case "BYMINUTE":
+			r.Minutes, err = parseInts(value, 0, 59)
+		case "BYDAY":
+			for _, v := range strings.Split(value, ",") {
+				n, d, e := parseNthWeekdayCode(v)
+				if e != nil {
+					err = e
+					break
+				}
+				if n == 0 {
+					r.Weekdays = append(r.Weekdays, d)
+				} else {
+					r.NthWeekdays = append(r.NthWeekdays, NthWeekday{N: n, Weekday: d})
+				}
+			}
+		case "WKST":
+			r.WeekStart, err = parseWeekdayCode(value)
+		default:
+			err = fmt.Errorf("unsupported rule part %q", part)
+		}
+
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("parsing %s: %w", name, err)
+		}
+	}
+
+	if r.Frequency == 0 {
+		return Recurrence{}, fmt.Errorf("missing FREQ")
+	}
+
+	if len(r.NthWeekdays) > 0 && r.Frequency != Monthly && (r.Frequency != Yearly || len(r.Months) == 0) {
+		return Recurrence{}, fmt.Errorf("numbered BYDAY requires FREQ=MONTHLY, or FREQ=YEARLY with BYMONTH")
+	}
+
+	if !r.minutesAlign() {
+		return Recurrence{}, fmt.Errorf("BYMINUTE never coincides with INTERVAL")
+	}
+
+	if r.Count > 0 && !r.Until.IsZero() {
+		return Recurrence{}, fmt.Errorf("COUNT and UNTIL must not occur together")
+	}
+
+	return r, nil
+}
+
+// joinInts returns values as a comma separated list.
+func joinInts(values []int) string {
+	var v []string
+	for _, n := range values {
+		v = append(v, strconv.Itoa(n))
+	}
+	return strings.Join(v, ",")
+}
+
+// parseInts parses a comma separated list of values from min to max,
+// returning them sorted.
+func parseInts(s string, min, max int) ([]int, error) {
+	var values []int
+	for _, v := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < min || n > max {
+			return nil, fmt.Errorf("invalid value %q", v)
+		}
+		values = addInt(values, n)
+	}
+	return values, nil
+}
+
+// parseFrequency parses an iCalendar frequency such as "WEEKLY".
+func parseFrequency(s string) (Frequency, error) {
+	for f := Minutely; f <= Yearly; f++ {
+		if strings.ToUpper(f.String()) == s {
+			return f, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported frequency %q", s)
+}
+
+// parsePositive parses a positive integer.
+func parsePositive(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return n, nil
+}
+
+// parseWeekdayCode parses an iCalendar day of the week such as "MO".
+func parseWeekdayCode(s string) (time.Weekday, error) {
+	for d, code := range weekdayCodes {
+		if code == s {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported day %q", s)
+}
+
+// parseNthWeekdayCode parses an iCalendar day of the week with an optional
+// number within the month, such as "MO", "4TH" or "-1MO". The number is zero
+// when omitted.
+func parseNthWeekdayCode(s string) (int, time.Weekday, error) {
+	i := strings.IndexFunc(s, func(r rune) bool { return r >= 'A' && r <= 'Z' })
+	if i <= 0 {
+		d, err := parseWeekdayCode(s)
+		return 0, d, err
+	}
+
+	n, err := strconv.Atoi(s[:i])
+	if err != nil || n == 0 || n < -5 || n > 5 {
+		return 0, 0, fmt.Errorf("unsupported day %q", s)
+	}
+
+	d, err := parseWeekdayCode(s[i:])
+	if err != nil {
+		return 0, 0, fmt.Errorf("unsupported day %q", s)
+	}
+
+	return n, d, nil
+}
+
+// parseRRuleDate parses an iCalendar DATE or DATE-TIME value, where dates
+// and local times are in the given location. Dates include the whole day.
+func parseRRuleDate(s string, loc *time.Location) (time.Time, error) {
+	switch {
+	case len(s) == 8:
+		t, err := time.ParseInLocation("20060102", s, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q", s)
+		}
+		return endOf(t, periodDay, time.Monday), nil
+	case strings.HasSuffix(s, "Z"):
+		t, err := time.Parse("20060102T150405Z", s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q", s)
+		}
+		return t.In(loc), nil
+	default:
+		t, err := time.ParseInLocation("20060102T150405", s, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q", s)
+		}
+		return t, nil
+	}
+}
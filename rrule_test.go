@@ -0,0 +1,157 @@
+package naturaldate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tj/assert"
+)
+
+var rruleCases = []struct {
+	Input  string
+	Output string
+}{
+	{`every monday at 9am`, `FREQ=WEEKLY;BYDAY=MO`},
+	{`every 2 weeks on friday`, `FREQ=WEEKLY;INTERVAL=2;BYDAY=FR`},
+	{`every weekday except fridays`, `FREQ=WEEKLY;BYDAY=MO,TU,WE,TH`},
+	{`every other day`, `FREQ=DAILY;INTERVAL=2`},
+	{`daily at noon`, `FREQ=DAILY`},
+	{`every 15 minutes`, `FREQ=MINUTELY;INTERVAL=15`},
+	{`every month on the 1st and 15th`, `FREQ=MONTHLY;BYMONTHDAY=1,15`},
+	{`every month except december`, `FREQ=MONTHLY;BYMONTH=1,2,3,4,5,6,7,8,9,10,11`},
+	{`every year on the 1st`, `FREQ=YEARLY;BYMONTH=11;BYMONTHDAY=1`},
+	{`every day at 9am and 5pm`, `FREQ=DAILY;BYHOUR=9,17;BYMINUTE=0`},
+	{`every hour on weekdays from 9am to 5pm`, `FREQ=HOURLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9,10,11,12,13,14,15,16,17`},
+	{`every 3 days for 10 times`, `FREQ=DAILY;INTERVAL=3;COUNT=10`},
+	{`every monday until december 1st`, `FREQ=WEEKLY;UNTIL=20191201T235959Z;BYDAY=MO`},
+}
+
+// Test converting recurrences to recurrence rules and back.
+func TestRecurrence_RRule(t *testing.T) {
+	for _, c := range rruleCases {
+		t.Run(c.Input, func(t *testing.T) {
+			r, err := ParseRecurrence(c.Input, base)
+			assert.NoError(t, err, "parsing")
+			assert.Equal(t, c.Output, r.RRule())
+
+			v, err := ParseRRule("RRULE:"+r.RRule(), r.Start)
+			assert.NoError(t, err, "parsing rule")
+			assert.Equal(t, c.Output, v.RRule())
+
+			end := base.AddDate(1, 0, 0)
+			assert.Equal(t, r.Between(base, end), v.Between(base, end))
+		})
+	}
+}
+
+// Test parsing recurrence rules.
+func TestParseRRule(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	start := time.Date(2019, time.November, 25, 9, 0, 0, 0, est)
+
+	r, err := ParseRRule(`FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;UNTIL=20191220;WKST=SU`, start)
+	assert.NoError(t, err, "parsing")
+	assert.Equal(t, Weekly, r.Frequency)
+	assert.Equal(t, 2, r.Interval)
+	assert.Equal(t, time.Sunday, r.WeekStart)
+	assert.Equal(t, []time.Weekday{time.Tuesday, time.Thursday}, r.Weekdays)
+	assert.Equal(t, `2019-12-20 23:59:59.999999999 -0500 EST`, r.Until.String())
+
+	var out []string
+	for _, v := range r.Between(start, start.AddDate(1, 0, 0)) {
+		out = append(out, v.String())
+	}
+
+	assert.Equal(t, []string{
+		`2019-11-26 09:00:00 -0500 EST`,
+		`2019-11-28 09:00:00 -0500 EST`,
+		`2019-12-10 09:00:00 -0500 EST`,
+		`2019-12-12 09:00:00 -0500 EST`,
+	}, out)
+}
+
+// Test parsing recurrence rules with numbered days of the week.
+func TestParseRRule_nthWeekday(t *testing.T) {
+	r, err := ParseRRule(`FREQ=MONTHLY;BYDAY=1MO,-1FR`, base)
+	assert.NoError(t, err, "parsing")
+	assert.Equal(t, []NthWeekday{{1, time.Monday}, {-1, time.Friday}}, r.NthWeekdays)
+	assert.Equal(t, `FREQ=MONTHLY;BYDAY=1MO,-1FR`, r.RRule())
+
+	var out []string
+	for _, v := range r.Between(base, base.AddDate(0, 2, 0)) {
+		out = append(out, v.Format("2006-01-02"))
+	}
+
+	assert.Equal(t, []string{`2019-11-29`, `2019-12-02`, `2019-12-27`, `2020-01-06`}, out)
+
+	r, err = ParseRRule(`FREQ=YEARLY;BYMONTH=11;BYDAY=4TH`, base)
+	assert.NoError(t, err, "parsing")
+	assert.Equal(t, `2019-11-28`, r.Next(base).Format("2006-01-02"))
+
+	_, err = r.Cron()
+	assert.EqualError(t, err, `numbered days of the week are not representable`)
+}
+
+// Test parsing invalid recurrence rules.
+func TestParseRRule_invalid(t *testing.T) {
+	cases := []struct {
+		Input string
+		Error string
+	}{
+		{`INTERVAL=2`, `missing FREQ`},
+		{`FREQ=SECONDLY`, `parsing FREQ: unsupported frequency "SECONDLY"`},
+		{`FREQ=DAILY;INTERVAL=0`, `parsing INTERVAL: invalid number "0"`},
+		{`FREQ=MONTHLY;BYDAY=6MO`, `parsing BYDAY: unsupported day "6MO"`},
+		{`FREQ=YEARLY;BYDAY=20MO`, `parsing BYDAY: unsupported day "20MO"`},
+		{`FREQ=YEARLY;BYDAY=-1MO`, `numbered BYDAY requires FREQ=MONTHLY, or FREQ=YEARLY with BYMONTH`},
+		{`FREQ=WEEKLY;BYDAY=1MO`, `numbered BYDAY requires FREQ=MONTHLY, or FREQ=YEARLY with BYMONTH`},
+		{`FREQ=DAILY;BYHOUR=24`, `parsing BYHOUR: invalid value "24"`},
+		{`FREQ=MONTHLY;BYSETPOS=1`, `parsing BYSETPOS: unsupported rule part "BYSETPOS=1"`},
+		{`FREQ=DAILY;UNTIL=tomorrow`, `parsing UNTIL: invalid date "TOMORROW"`},
+		{`FREQ`, `invalid rule part "FREQ"`},
+		{`FREQ=DAILY;COUNT=3;UNTIL=20191201`, `COUNT and UNTIL must not occur together`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Input, func(t *testing.T) {
+			_, err := ParseRRule(c.Input, base)
+			assert.EqualError(t, err, c.Error)
+		})
+	}
+}
+
+// Test converting recurrences to iCalendar components.
+func TestRecurrence_VEvent(t *testing.T) {
+	defer func(fn func() time.Time) { now = fn }(now)
+	now = func() time.Time { return base }
+
+	r, err := ParseRecurrence(`every weekday at 8:30`, base.In(time.FixedZone("EST", -5*60*60)))
+	assert.NoError(t, err, "parsing")
+
+	assert.Equal(t, "BEGIN:VEVENT\r\n"+
+		"UID:3c23a70b4638b041@naturaldate\r\n"+
+		"DTSTAMP:20191125T130718Z\r\n"+
+		"DTSTART;TZID=EST:20191125T083000\r\n"+
+		"RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR\r\n"+
+		"SUMMARY:Stand-up\\, daily\r\n"+
+		"END:VEVENT\r\n", r.VEvent("Stand-up, daily"))
+
+	r, err = ParseRecurrence(`every monday`, base)
+	assert.NoError(t, err, "parsing")
+
+	assert.Equal(t, "BEGIN:VTODO\r\n"+
+		"UID:45269457ceb80268@naturaldate\r\n"+
+		"DTSTAMP:20191125T130718Z\r\n"+
+		"DTSTART:20191125T000000Z\r\n"+
+		"RRULE:FREQ=WEEKLY;BYDAY=MO\r\n"+
+		"SUMMARY:Water the plants and then check on the tomatoes in the greenhouse p\r\n"+
+		" lease\r\n"+
+		"END:VTODO\r\n", r.VTodo("Water the plants and then check on the tomatoes in the greenhouse please"))
+
+	for _, loc := range []*time.Location{time.Local, time.FixedZone("", 2*60*60), time.FixedZone("CET", 2*60*60)} {
+		r, err = ParseRecurrence(`every monday at 9am`, base.In(loc))
+		assert.NoError(t, err, "parsing")
+		start := r.Start.UTC().Format("20060102T150405Z")
+		assert.Contains(t, r.VEvent("Review"), "\r\nDTSTART:"+start+"\r\n")
+	}
+}